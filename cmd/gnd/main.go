@@ -11,28 +11,114 @@ import (
 	"github.com/hyperifyio/gnd/pkg/parsers"
 	"github.com/hyperifyio/gnd/pkg/primitive_services"
 	"github.com/hyperifyio/gnd/pkg/primitives"
+	"github.com/hyperifyio/gnd/pkg/prompts"
+	"github.com/hyperifyio/gnd/pkg/runtime_errors"
+	"github.com/hyperifyio/gnd/pkg/units"
 )
 
 func printHelp() {
-	fmt.Print(`Usage: gnd [options] <script.gnd>
+	fmt.Print(`Usage: gnd [options] <script.gnd|script.gnc>
+       gnd compile [options] <script.gnd>
 Options:
   -h, --help      Show this help message and exit
   -v, --verbose   Enable verbose (debug) logging
+  --strict        Reject scripts that do not follow the syntax specification
+  --max-iterations <n>
+                  Limit iterations of range, repeat and while (default: 10000,
+                  0 disables the limit)
+  --no-fs         Disable the file primitives read-file, write-file, list-dir
+                  and exists
+  --fs-root <dir> Allow the file primitives to access <dir> and everything
+                  below it; may be repeated (default: the directory of each
+                  unit)
+  --allow-run <program>
+                  Allow the run primitive to execute <program>; may be
+                  repeated (default: no programs)
+  --prompt-cache <dir>
+                  Directory of recorded prompt replies (default: .gnd-prompts)
+  --prompt-cache-mode <mode>
+                  Prompt cache mode: off, record, replay or replay-or-fail
 
 Arguments:
   <script.gnd>    Path to the GND script to execute
 
+A compiled .gnc file next to the script is used instead of the .gnd
+fragments when it is at least as new as all of them.
+
+Compile options:
+  -o <file>       Write the compiled unit to <file> (default: <unit>.gnc)
+
+In replay mode prompt replies are read from the cache and missing replies
+are recorded. In replay-or-fail mode a missing reply is an error, so the
+script runs without a language model server.
+
 Examples:
   gnd examples/debug.gnd
   gnd --verbose examples/debug.gnd
+  gnd --no-fs examples/llm.gnd
+  gnd --allow-run gofmt --allow-run git pipeline.gnd
+  gnd --prompt-cache testdata/prompts --prompt-cache-mode replay-or-fail examples/llm.gnd
+  gnd compile examples/debug.gnd
 `)
 }
 
+// compileMain implements the "gnd compile" subcommand
+func compileMain(args []string) {
+	flags := flag.NewFlagSet("compile", flag.ExitOnError)
+	out := flags.String("o", "", "Output file")
+	verbose := flags.Bool("verbose", false, "Enable verbose (debug) logging")
+	v := flags.Bool("v", false, "Enable verbose (debug) logging (shorthand)")
+	strict := flags.Bool("strict", false, "Use the strict parser")
+	flags.Usage = printHelp
+	flags.Parse(args)
+
+	if *verbose || *v {
+		loggers.Level = loggers.Debug
+	}
+	parsers.StrictMode = *strict
+
+	if flags.NArg() != 1 {
+		fmt.Fprintln(os.Stderr, "Error: compile expects exactly one script file")
+		printHelp()
+		os.Exit(1)
+	}
+
+	scriptPath := flags.Arg(0)
+	outPath := *out
+	if outPath == "" {
+		outPath = units.GncPath(scriptPath)
+	}
+
+	if err := units.CompileUnitFile(scriptPath, outPath, primitive_services.GetDefaultOpcodeMap()); err != nil {
+		fmt.Printf("Error compiling script: %v\n", err)
+		os.Exit(1)
+	}
+	loggers.Printf(loggers.Debug, "compiled %s to %s", scriptPath, outPath)
+}
+
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "compile" {
+		compileMain(os.Args[2:])
+		return
+	}
+
 	help := flag.Bool("help", false, "Show help")
 	h := flag.Bool("h", false, "Show help (shorthand)")
 	verbose := flag.Bool("verbose", false, "Enable verbose (debug) logging")
 	v := flag.Bool("v", false, "Enable verbose (debug) logging (shorthand)")
+	strict := flag.Bool("strict", false, "Use the strict parser")
+	maxIterations := flag.Int("max-iterations", primitives.MaxLoopIterations, "Limit iterations of range, repeat and while")
+	noFs := flag.Bool("no-fs", false, "Disable the file primitives")
+	flag.Func("fs-root", "Directory the file primitives may access (repeatable)", func(dir string) error {
+		primitives.FileRoots = append(primitives.FileRoots, dir)
+		return nil
+	})
+	flag.Func("allow-run", "Program the run primitive may execute (repeatable)", func(program string) error {
+		primitives.RunAllowlist = append(primitives.RunAllowlist, program)
+		return nil
+	})
+	promptCache := flag.String("prompt-cache", "", "Directory of recorded prompt replies")
+	promptCacheMode := flag.String("prompt-cache-mode", "", "Prompt cache mode: off, record, replay or replay-or-fail")
 	flag.Parse()
 
 	if *help || *h {
@@ -43,6 +129,16 @@ func main() {
 	if *verbose || *v {
 		loggers.Level = loggers.Debug
 	}
+	parsers.StrictMode = *strict
+	primitives.MaxLoopIterations = *maxIterations
+	primitives.FileAccessEnabled = !*noFs
+
+	if _, err := prompts.ParseCacheMode(*promptCacheMode); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	prompts.CacheDirOverride = *promptCache
+	prompts.CacheModeOverride = *promptCacheMode
 
 	if len(flag.Args()) < 1 {
 		fmt.Fprintln(os.Stderr, "Error: missing script file")
@@ -63,17 +159,10 @@ func main() {
 	interpreterImpl := interpreters.NewInterpreter(scriptDir, primitive_services.GetDefaultOpcodeMap())
 	interpreterImpl.SetSlot("_", scriptArgs)
 
-	// Read the script file
-	content, err := os.ReadFile(scriptPath)
+	// Load and parse all fragments of the script unit
+	instructions, err := units.LoadUnitFile(scriptPath)
 	if err != nil {
-		fmt.Printf("Error reading the script: %s: %v\n", scriptPath, err)
-		os.Exit(1)
-	}
-
-	// Parse the instructions
-	instructions, err := parsers.ParseInstructionLines(scriptPath, string(content))
-	if err != nil {
-		fmt.Printf("Error parsing script: %v\n", err)
+		fmt.Printf("Error loading script: %v\n", err)
 		os.Exit(1)
 	}
 	loggers.Printf(loggers.Debug, "loaded %d instructions", len(instructions))
@@ -86,6 +175,10 @@ func main() {
 		if exitErr, ok := primitives.GetExitResult(err); ok {
 			value = exitErr.Value
 			status = exitErr.Code
+		} else if gndErr, ok := runtime_errors.GetGndError(err); ok {
+			fmt.Println(gndErr.Traceback())
+			value = nil
+			status = 1
 		} else {
 			fmt.Printf("Error executing instruction: %v\n", err)
 			value = nil
@@ -0,0 +1,101 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/hyperifyio/gnd/pkg/compilers"
+	"github.com/hyperifyio/gnd/pkg/loggers"
+	"github.com/hyperifyio/gnd/pkg/prompts"
+)
+
+func printHelp() {
+	fmt.Print(`Usage: gndc [options] [directory ...]
+Options:
+  -h, --help      Show this help message and exit
+  -v, --verbose   Enable verbose (debug) logging
+  -f, --force     Recompile units even if the .gnd file is up to date
+
+Arguments:
+  [directory]     Directories containing .llm and .gnd.llm files (default: .)
+
+Environment:
+  GND_PROMPT_BACKEND    Backend: openai (default), llamacpp, ollama or fake
+  GND_PROMPT_CONFIG     Path of the prompt configuration file
+  GND_PROMPT_URL        Base URL of the language model server
+  GND_PROMPT_MODEL      Model name
+  GND_PROMPT_FAKE_FILE  Response file of the fake backend
+  OPENAI_API_KEY        API key for the openai backend (required by it)
+  OPENAI_API_URL        Base URL of the openai backend
+  OPENAI_MODEL          Model name of the openai backend
+
+Examples:
+  gndc examples
+  gndc --force --verbose examples
+`)
+}
+
+func main() {
+	help := flag.Bool("help", false, "Show help")
+	h := flag.Bool("h", false, "Show help (shorthand)")
+	verbose := flag.Bool("verbose", false, "Enable verbose (debug) logging")
+	v := flag.Bool("v", false, "Enable verbose (debug) logging (shorthand)")
+	force := flag.Bool("force", false, "Recompile units even if up to date")
+	f := flag.Bool("f", false, "Recompile units even if up to date (shorthand)")
+	flag.Parse()
+
+	if *help || *h {
+		printHelp()
+		return
+	}
+
+	if *verbose || *v {
+		loggers.Level = loggers.Debug
+	}
+
+	dirs := flag.Args()
+	if len(dirs) == 0 {
+		dirs = []string{"."}
+	}
+
+	backend, config, err := prompts.LoadBackend()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	if config.RequiresApiKey() {
+		fmt.Fprintln(os.Stderr, "Error: OPENAI_API_KEY environment variable not set")
+		os.Exit(1)
+	}
+
+	compiler := compilers.NewCompiler(backend, config)
+	compiler.Force = *force || *f
+
+	failed := 0
+	for _, dir := range dirs {
+		units, err := compilers.DiscoverUnits(dir)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			failed++
+			continue
+		}
+		loggers.Printf(loggers.Debug, "found %d units in %s", len(units), dir)
+
+		for _, unit := range units {
+			written, err := compiler.CompileUnit(unit)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error compiling %s: %v\n", unit.OutputPath, err)
+				failed++
+				continue
+			}
+			if written {
+				fmt.Printf("compiled %s\n", unit.OutputPath)
+			}
+		}
+	}
+
+	if failed != 0 {
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,101 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/hyperifyio/gnd/pkg/loggers"
+	"github.com/hyperifyio/gnd/pkg/prompts"
+	"github.com/hyperifyio/gnd/pkg/test_runners"
+)
+
+func printHelp() {
+	fmt.Print(`Usage: gndtest [options] [path ...]
+Options:
+  -h, --help      Show this help message and exit
+  -v, --verbose   Enable verbose (debug) logging
+  --skip-llm      Skip model-judged .test.llm tests
+
+Arguments:
+  [path]          Directories to search recursively, or test files (default: .)
+
+Environment:
+  GND_PROMPT_BACKEND    Backend: openai (default), llamacpp, ollama or fake
+  GND_PROMPT_CONFIG     Path of the prompt configuration file
+  GND_PROMPT_URL        Base URL of the language model server
+  GND_PROMPT_MODEL      Model name
+  GND_PROMPT_FAKE_FILE  Response file of the fake backend
+  OPENAI_API_KEY        API key for the openai backend (for .test.llm)
+  OPENAI_API_URL        Base URL of the openai backend
+  OPENAI_MODEL          Model name of the openai backend
+
+Examples:
+  gndtest examples
+  gndtest --skip-llm examples/sum.test.gnd
+`)
+}
+
+func main() {
+	help := flag.Bool("help", false, "Show help")
+	h := flag.Bool("h", false, "Show help (shorthand)")
+	verbose := flag.Bool("verbose", false, "Enable verbose (debug) logging")
+	v := flag.Bool("v", false, "Enable verbose (debug) logging (shorthand)")
+	skipLlm := flag.Bool("skip-llm", false, "Skip model-judged .test.llm tests")
+	flag.Parse()
+
+	if *help || *h {
+		printHelp()
+		return
+	}
+
+	if *verbose || *v {
+		loggers.Level = loggers.Debug
+	}
+
+	paths := flag.Args()
+	if len(paths) == 0 {
+		paths = []string{"."}
+	}
+
+	backend, config, err := prompts.LoadBackend()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	runner := test_runners.NewRunner(backend, config)
+	runner.SkipLlm = *skipLlm
+
+	var tests []*test_runners.TestCase
+	for _, path := range paths {
+		found, err := test_runners.DiscoverTests(path)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		tests = append(tests, found...)
+	}
+
+	passed, failed, skipped := 0, 0, 0
+	for _, tc := range tests {
+		result := runner.Run(tc)
+		switch {
+		case result.Skipped:
+			skipped++
+			fmt.Printf("SKIP %s: %s\n", tc.Path, result.Message)
+		case result.Passed:
+			passed++
+			fmt.Printf("PASS %s (%v)\n", tc.Path, result.Duration)
+		default:
+			failed++
+			fmt.Printf("FAIL %s (%v): %s\n", tc.Path, result.Duration, result.Message)
+		}
+	}
+
+	fmt.Printf("\n%d passed, %d failed, %d skipped\n", passed, failed, skipped)
+
+	if failed != 0 {
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,48 @@
+package compilers
+
+import (
+	"strings"
+)
+
+// CompilerInstructions describes the .gnd format for the language model
+const CompilerInstructions = `You are the Gendo compiler. Write the implementation of a Gendo unit as a .gnd file.
+
+Rules of the .gnd format:
+- Each line is exactly one instruction: [ $destination ] opcode [ argument ... ]
+- Lines starting with # are comments. Blank lines are ignored.
+- If the destination is omitted, the result is bound to the implicit slot _.
+- On entry _ holds the array of arguments passed to the unit; the value of _ after the last instruction is the result.
+- Each instruction implicitly consumes _ unless arguments are given.
+- Variable references use the $ prefix. A $variable may be bound only once and only used after it is bound.
+- Literals are integers, floats, double-quoted strings with C-style escapes, or [ arrays ].
+- String literals must close on the same line. There are no multi-line constructs.
+`
+
+// BuildPrompt constructs the prompt sent to the language model for a unit
+func BuildPrompt(unit *Unit, header, prompt string) string {
+	var b strings.Builder
+	b.WriteString(CompilerInstructions)
+	b.WriteString("\n")
+
+	if unit.IsTest() {
+		b.WriteString("Write a test pipeline for the unit \"" + strings.TrimSuffix(unit.Name, TestSuffix) + "\". ")
+		b.WriteString("The pipeline must use throw or a non-zero exit when an assertion fails.\n")
+	} else {
+		b.WriteString("Write the implementation of the unit \"" + unit.Name + "\".\n")
+	}
+
+	if header != "" {
+		b.WriteString("\nUnit header:\n---\n")
+		b.WriteString(strings.TrimSpace(header))
+		b.WriteString("\n---\n")
+	}
+
+	if prompt != "" {
+		b.WriteString("\nImplementation prompt:\n---\n")
+		b.WriteString(strings.TrimSpace(prompt))
+		b.WriteString("\n---\n")
+	}
+
+	b.WriteString("\nReply with the contents of the .gnd file only, without explanations or Markdown code fences.\n")
+	return b.String()
+}
@@ -0,0 +1,107 @@
+package compilers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/hyperifyio/gnd/pkg/loggers"
+	"github.com/hyperifyio/gnd/pkg/parsers"
+	"github.com/hyperifyio/gnd/pkg/prompts"
+)
+
+var CompilerNoInstructionsError = errors.New("compiler: generated implementation has no instructions")
+
+// Compiler expands unit prompts into .gnd implementations using a language model
+type Compiler struct {
+	Backend prompts.Backend
+	Config  prompts.PromptConfig
+	// Force recompiles units even when the output is newer than the sources
+	Force bool
+}
+
+// NewCompiler creates a new compiler
+func NewCompiler(backend prompts.Backend, config prompts.PromptConfig) *Compiler {
+	return &Compiler{
+		Backend: backend,
+		Config:  config,
+	}
+}
+
+// IsUpToDate returns true if the unit's output is newer than all of its sources
+func (c *Compiler) IsUpToDate(unit *Unit) bool {
+	out, err := os.Stat(unit.OutputPath)
+	if err != nil {
+		return false
+	}
+	for _, src := range []string{unit.HeaderPath, unit.PromptPath} {
+		if src == "" {
+			continue
+		}
+		info, err := os.Stat(src)
+		if err != nil || info.ModTime().After(out.ModTime()) {
+			return false
+		}
+	}
+	return true
+}
+
+// GenerateUnit asks the language model for the unit implementation and
+// returns the source only if it parses as valid .gnd code
+func (c *Compiler) GenerateUnit(unit *Unit) (string, error) {
+	var header, prompt string
+	if unit.HeaderPath != "" {
+		content, err := os.ReadFile(unit.HeaderPath)
+		if err != nil {
+			return "", fmt.Errorf("[%s]: GenerateUnit: failed to read header: %w", unit.Name, err)
+		}
+		header = string(content)
+	}
+	if unit.PromptPath != "" {
+		content, err := os.ReadFile(unit.PromptPath)
+		if err != nil {
+			return "", fmt.Errorf("[%s]: GenerateUnit: failed to read prompt: %w", unit.Name, err)
+		}
+		prompt = string(content)
+	}
+
+	reply, err := c.Backend.Complete(context.Background(), c.Config.NewCompletionRequest(BuildPrompt(unit, header, prompt)))
+	if err != nil {
+		return "", fmt.Errorf("[%s]: GenerateUnit: prompt failed: %w", unit.Name, err)
+	}
+	source := StripCodeFence(reply)
+	loggers.Printf(loggers.Debug, "[%s]: GenerateUnit: generated:\n%s", unit.Name, source)
+
+	instructions, err := parsers.ParseInstructionLinesStrict(unit.OutputPath, source)
+	if err != nil {
+		return "", fmt.Errorf("[%s]: GenerateUnit: generated code is invalid: %w", unit.Name, err)
+	}
+	if len(instructions) == 0 {
+		return "", fmt.Errorf("[%s]: GenerateUnit: %w", unit.Name, CompilerNoInstructionsError)
+	}
+	if err := parsers.ValidateInstructions(instructions); err != nil {
+		return "", fmt.Errorf("[%s]: GenerateUnit: generated code is invalid:\n%w", unit.Name, err)
+	}
+	return source, nil
+}
+
+// CompileUnit generates the unit implementation and writes it to the output path.
+// Returns false if the unit was up to date and nothing was written.
+func (c *Compiler) CompileUnit(unit *Unit) (bool, error) {
+	if !c.Force && c.IsUpToDate(unit) {
+		loggers.Printf(loggers.Debug, "[%s]: CompileUnit: up to date: %s", unit.Name, unit.OutputPath)
+		return false, nil
+	}
+
+	source, err := c.GenerateUnit(unit)
+	if err != nil {
+		return false, err
+	}
+
+	if err := os.WriteFile(unit.OutputPath, []byte(source), 0644); err != nil {
+		return false, fmt.Errorf("[%s]: CompileUnit: failed to write output: %w", unit.Name, err)
+	}
+	loggers.Printf(loggers.Info, "[%s]: CompileUnit: wrote %s", unit.Name, unit.OutputPath)
+	return true, nil
+}
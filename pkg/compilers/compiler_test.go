@@ -0,0 +1,155 @@
+package compilers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hyperifyio/gnd/pkg/prompts"
+	"github.com/stretchr/testify/assert"
+)
+
+// newModelServer returns a test server that replies to every chat completion with reply
+func newModelServer(t *testing.T, reply string, requests *[]prompts.ChatRequest) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/chat/completions", r.URL.Path)
+		var req prompts.ChatRequest
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		if requests != nil {
+			*requests = append(*requests, req)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"choices": []interface{}{
+				map[string]interface{}{
+					"message": map[string]interface{}{"role": "assistant", "content": reply},
+				},
+			},
+		})
+	}))
+}
+
+func newTestCompiler(server *httptest.Server) *Compiler {
+	config := prompts.DefaultConfig()
+	return NewCompiler(prompts.NewOpenAIBackend(server.Client(), server.URL, "test-key"), config)
+}
+
+func TestCompileUnit(t *testing.T) {
+	var requests []prompts.ChatRequest
+	server := newModelServer(t, "```gnd\ntrim\nlowercase\n```", &requests)
+	defer server.Close()
+
+	dir := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "normalize.llm"), []byte("Normalizes model replies."), 0644))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "normalize.gnd.llm"), []byte("Trim and lowercase the input."), 0644))
+
+	units, err := DiscoverUnits(dir)
+	assert.NoError(t, err)
+	assert.Len(t, units, 1)
+
+	compiler := newTestCompiler(server)
+	written, err := compiler.CompileUnit(units[0])
+	assert.NoError(t, err)
+	assert.True(t, written)
+
+	content, err := os.ReadFile(filepath.Join(dir, "normalize.gnd"))
+	assert.NoError(t, err)
+	assert.Equal(t, "trim\nlowercase\n", string(content))
+
+	assert.Len(t, requests, 1)
+	prompt := requests[0].Messages[0].Content
+	assert.True(t, strings.Contains(prompt, "Normalizes model replies."))
+	assert.True(t, strings.Contains(prompt, "Trim and lowercase the input."))
+
+	// The output is now newer than the sources
+	written, err = compiler.CompileUnit(units[0])
+	assert.NoError(t, err)
+	assert.False(t, written)
+	assert.Len(t, requests, 1)
+
+	// Forced compilation ignores timestamps
+	compiler.Force = true
+	written, err = compiler.CompileUnit(units[0])
+	assert.NoError(t, err)
+	assert.True(t, written)
+	assert.Len(t, requests, 2)
+}
+
+func TestCompileUnitRecompilesStaleOutput(t *testing.T) {
+	server := newModelServer(t, "trim", nil)
+	defer server.Close()
+
+	dir := t.TempDir()
+	header := filepath.Join(dir, "foo.llm")
+	output := filepath.Join(dir, "foo.gnd")
+	assert.NoError(t, os.WriteFile(output, []byte("old\n"), 0644))
+	assert.NoError(t, os.WriteFile(header, []byte("Foo."), 0644))
+	past := time.Now().Add(-time.Hour)
+	assert.NoError(t, os.Chtimes(output, past, past))
+
+	compiler := newTestCompiler(server)
+	written, err := compiler.CompileUnit(&Unit{Name: "foo", Dir: dir, HeaderPath: header, OutputPath: output})
+	assert.NoError(t, err)
+	assert.True(t, written)
+
+	content, err := os.ReadFile(output)
+	assert.NoError(t, err)
+	assert.Equal(t, "trim\n", string(content))
+}
+
+func TestCompileUnitRejectsInvalidCode(t *testing.T) {
+	tests := []struct {
+		name  string
+		reply string
+	}{
+		{
+			name:  "unterminated string",
+			reply: "$x let \"unterminated",
+		},
+		{
+			name:  "no instructions",
+			reply: "# just a comment",
+		},
+		{
+			name:  "smart quotes",
+			reply: "$x let “hello”",
+		},
+		{
+			name:  "rebound identifier",
+			reply: "$x let 1\n$x let 2",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newModelServer(t, tt.reply, nil)
+			defer server.Close()
+
+			dir := t.TempDir()
+			header := filepath.Join(dir, "bad.llm")
+			output := filepath.Join(dir, "bad.gnd")
+			assert.NoError(t, os.WriteFile(header, []byte("Bad unit."), 0644))
+
+			compiler := newTestCompiler(server)
+			written, err := compiler.CompileUnit(&Unit{Name: "bad", Dir: dir, HeaderPath: header, OutputPath: output})
+			assert.Error(t, err)
+			assert.False(t, written)
+
+			_, err = os.Stat(output)
+			assert.True(t, os.IsNotExist(err), "output must not be written")
+		})
+	}
+}
+
+func TestBuildPrompt(t *testing.T) {
+	prompt := BuildPrompt(&Unit{Name: "sum.test"}, "Sums numbers.", "")
+	assert.Contains(t, prompt, CompilerInstructions)
+	assert.Contains(t, prompt, "test pipeline for the unit \"sum\"")
+	assert.Contains(t, prompt, "Sums numbers.")
+	assert.NotContains(t, prompt, "Implementation prompt")
+}
@@ -0,0 +1,19 @@
+package compilers
+
+import (
+	"strings"
+
+	"github.com/hyperifyio/gnd/pkg/extractors"
+)
+
+// StripCodeFence removes a surrounding Markdown code fence from a model reply.
+// If the reply contains a fenced block, only the contents of the first block
+// are returned. Otherwise the reply is returned trimmed.
+func StripCodeFence(reply string) string {
+	code, err := extractors.ExtractCode(reply, "")
+	if err != nil {
+		// An empty reply, reported by the compiler as having no instructions
+		return "\n"
+	}
+	return strings.TrimSpace(code) + "\n"
+}
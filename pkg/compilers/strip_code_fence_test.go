@@ -0,0 +1,57 @@
+package compilers
+
+import (
+	"testing"
+)
+
+func TestStripCodeFence(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "plain code",
+			input:    "trim\nlowercase\n",
+			expected: "trim\nlowercase\n",
+		},
+		{
+			name:     "surrounding whitespace",
+			input:    "\n\n  trim\n\n",
+			expected: "trim\n",
+		},
+		{
+			name:     "fenced block with language",
+			input:    "```gnd\ntrim\nlowercase\n```",
+			expected: "trim\nlowercase\n",
+		},
+		{
+			name:     "fenced block with prose",
+			input:    "Here is the code:\r\n```\r\ntrim\r\n```\r\nHope this helps!",
+			expected: "trim\n",
+		},
+		{
+			name:     "only first block is used",
+			input:    "```\nfirst\n```\n```\nsecond\n```",
+			expected: "first\n",
+		},
+		{
+			name:     "tilde fence",
+			input:    "~~~\ntrim\n~~~\n",
+			expected: "trim\n",
+		},
+		{
+			name:     "unterminated fence",
+			input:    "```gnd\ntrim\nlowercase",
+			expected: "trim\nlowercase\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := StripCodeFence(tt.input); got != tt.expected {
+				t.Errorf("StripCodeFence(%q) = %q, want %q", tt.input, got, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package compilers
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const (
+	HeaderExt         = ".llm"
+	PromptExt         = ".gnd.llm"
+	ImplementationExt = ".gnd"
+	TestSuffix        = ".test"
+	TestHeaderExt     = TestSuffix + HeaderExt
+)
+
+// Unit describes a single compilable Gendo unit
+type Unit struct {
+	// Name is the base name of the unit, e.g. "sum" or "sum.test"
+	Name string
+	// Dir is the directory containing the unit files
+	Dir string
+	// HeaderPath is the path to the natural-language header (.llm), or empty if missing
+	HeaderPath string
+	// PromptPath is the path to the implementation prompt (.gnd.llm), or empty if missing
+	PromptPath string
+	// OutputPath is the path to the generated implementation (.gnd)
+	OutputPath string
+}
+
+// IsTest returns true if the unit is a test pipeline (.test.gnd.llm)
+func (u *Unit) IsTest() bool {
+	return strings.HasSuffix(u.Name, TestSuffix)
+}
+
+// String returns a string representation of the Unit
+func (u *Unit) String() string {
+	return "Unit{" + filepath.Join(u.Dir, u.Name) + "}"
+}
+
+// DiscoverUnits finds all compilable units in a directory.
+//
+// A unit is compilable when it has a header (name.llm) or an implementation
+// prompt (name.gnd.llm). Test pipelines (name.test.gnd.llm) use the header of
+// the unit under test (name.llm) as context. Model-judged tests
+// (name.test.llm) are not compiled; they are evaluated by gndtest.
+func DiscoverUnits(dir string) ([]*Unit, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("[%s]: DiscoverUnits: failed to read directory: %w", dir, err)
+	}
+
+	files := make(map[string]bool)
+	names := make(map[string]bool)
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		file := entry.Name()
+		files[file] = true
+		switch {
+		case strings.HasSuffix(file, PromptExt):
+			names[strings.TrimSuffix(file, PromptExt)] = true
+		case strings.HasSuffix(file, TestHeaderExt):
+			// Model-judged test, evaluated directly by gndtest
+		case strings.HasSuffix(file, HeaderExt):
+			names[strings.TrimSuffix(file, HeaderExt)] = true
+		}
+	}
+
+	var units []*Unit
+	for name := range names {
+		if name == "" {
+			continue
+		}
+		unit := &Unit{
+			Name:       name,
+			Dir:        dir,
+			OutputPath: filepath.Join(dir, name+ImplementationExt),
+		}
+		headerName := strings.TrimSuffix(name, TestSuffix) + HeaderExt
+		if files[headerName] {
+			unit.HeaderPath = filepath.Join(dir, headerName)
+		}
+		if files[name+PromptExt] {
+			unit.PromptPath = filepath.Join(dir, name+PromptExt)
+		}
+		units = append(units, unit)
+	}
+
+	sort.Slice(units, func(a, b int) bool {
+		return units[a].Name < units[b].Name
+	})
+	return units, nil
+}
@@ -0,0 +1,65 @@
+package compilers
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiscoverUnits(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{
+		"sum.llm",
+		"sum.gnd.llm",
+		"greet.llm",
+		"prompt-only.gnd.llm",
+		"sum.test.gnd.llm",
+		"sum.test.llm",
+		"notes.txt",
+	} {
+		assert.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte("x"), 0644))
+	}
+	assert.NoError(t, os.Mkdir(filepath.Join(dir, "sub.llm"), 0755))
+
+	units, err := DiscoverUnits(dir)
+	assert.NoError(t, err)
+
+	assert.Equal(t, []*Unit{
+		{
+			Name:       "greet",
+			Dir:        dir,
+			HeaderPath: filepath.Join(dir, "greet.llm"),
+			OutputPath: filepath.Join(dir, "greet.gnd"),
+		},
+		{
+			Name:       "prompt-only",
+			Dir:        dir,
+			PromptPath: filepath.Join(dir, "prompt-only.gnd.llm"),
+			OutputPath: filepath.Join(dir, "prompt-only.gnd"),
+		},
+		{
+			Name:       "sum",
+			Dir:        dir,
+			HeaderPath: filepath.Join(dir, "sum.llm"),
+			PromptPath: filepath.Join(dir, "sum.gnd.llm"),
+			OutputPath: filepath.Join(dir, "sum.gnd"),
+		},
+		{
+			Name:       "sum.test",
+			Dir:        dir,
+			HeaderPath: filepath.Join(dir, "sum.llm"),
+			PromptPath: filepath.Join(dir, "sum.test.gnd.llm"),
+			OutputPath: filepath.Join(dir, "sum.test.gnd"),
+		},
+	}, units)
+
+	assert.False(t, units[2].IsTest())
+	assert.True(t, units[3].IsTest())
+}
+
+func TestDiscoverUnitsMissingDir(t *testing.T) {
+	_, err := DiscoverUnits(filepath.Join(t.TempDir(), "missing"))
+	assert.Error(t, err)
+}
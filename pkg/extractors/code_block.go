@@ -0,0 +1,67 @@
+package extractors
+
+import (
+	"strings"
+)
+
+// CodeBlock is a fenced code block of a Markdown text
+type CodeBlock struct {
+	// Lang is the first word of the info string in lowercase, e.g. "json"
+	Lang string
+	// Code is the content of the block without the fences
+	Code string
+	// Terminated is false if the text ended before the closing fence
+	Terminated bool
+}
+
+// FindCodeBlocks returns the fenced code blocks of text in order. Fences are
+// runs of at least three backticks or tildes and may be indented. A block is
+// closed by a fence of the same character that is at least as long as the
+// opening fence and has no info string. A block that is not closed extends
+// to the end of the text, as replies may be truncated.
+func FindCodeBlocks(text string) []CodeBlock {
+	lines := strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n")
+	var blocks []CodeBlock
+	for idx := 0; idx < len(lines); idx++ {
+		fence, info, ok := parseFence(lines[idx])
+		if !ok {
+			continue
+		}
+		block := CodeBlock{Lang: strings.ToLower(firstWord(info))}
+		start := idx + 1
+		for idx = start; idx < len(lines); idx++ {
+			closing, closingInfo, ok := parseFence(lines[idx])
+			if ok && closingInfo == "" && closing[0] == fence[0] && len(closing) >= len(fence) {
+				block.Terminated = true
+				break
+			}
+		}
+		block.Code = strings.Join(lines[start:idx], "\n")
+		blocks = append(blocks, block)
+	}
+	return blocks
+}
+
+// parseFence returns the fence and the info string of a fence line
+func parseFence(line string) (string, string, bool) {
+	line = strings.TrimSpace(line)
+	if len(line) < 3 || (line[0] != '`' && line[0] != '~') {
+		return "", "", false
+	}
+	n := 0
+	for n < len(line) && line[n] == line[0] {
+		n++
+	}
+	if n < 3 {
+		return "", "", false
+	}
+	return line[:n], strings.TrimSpace(line[n:]), true
+}
+
+// firstWord returns the first whitespace separated word of s
+func firstWord(s string) string {
+	if fields := strings.Fields(s); len(fields) > 0 {
+		return fields[0]
+	}
+	return ""
+}
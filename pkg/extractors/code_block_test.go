@@ -0,0 +1,64 @@
+package extractors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindCodeBlocks(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []CodeBlock
+	}{
+		{
+			name:  "no blocks",
+			input: "just prose",
+			want:  nil,
+		},
+		{
+			name:  "blocks with prose",
+			input: "Here:\n```JSON\n{}\n```\nand\n~~~ python extra\nprint(1)\n~~~\n",
+			want: []CodeBlock{
+				{Lang: "json", Code: "{}", Terminated: true},
+				{Lang: "python", Code: "print(1)", Terminated: true},
+			},
+		},
+		{
+			name:  "windows line endings",
+			input: "```\r\na\r\nb\r\n```",
+			want:  []CodeBlock{{Code: "a\nb", Terminated: true}},
+		},
+		{
+			name:  "indented fences",
+			input: "  ```go\n  x := 1\n  ```",
+			want:  []CodeBlock{{Lang: "go", Code: "  x := 1", Terminated: true}},
+		},
+		{
+			name:  "longer fence contains shorter fences",
+			input: "````md\n```\ninner\n```\n````",
+			want:  []CodeBlock{{Lang: "md", Code: "```\ninner\n```", Terminated: true}},
+		},
+		{
+			name:  "fence with info string does not close",
+			input: "```\na\n```gnd\nb\n```",
+			want:  []CodeBlock{{Code: "a\n```gnd\nb", Terminated: true}},
+		},
+		{
+			name:  "unterminated block",
+			input: "```gnd\ntrim\nlowercase",
+			want:  []CodeBlock{{Lang: "gnd", Code: "trim\nlowercase"}},
+		},
+		{
+			name:  "two backticks are not a fence",
+			input: "``\ncode\n``",
+			want:  nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, FindCodeBlocks(tt.input))
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package extractors
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	CodeNotFoundError = errors.New("no code block found")
+	EmptyTextError    = errors.New("text is empty")
+)
+
+// ExtractCode returns the code of the first fenced block of text whose
+// language is lang, or of the first block if lang is empty. Languages are
+// compared case-insensitively. A text without any fenced block is taken to be
+// bare code and is returned trimmed.
+func ExtractCode(text, lang string) (string, error) {
+	blocks := FindCodeBlocks(text)
+	if len(blocks) == 0 {
+		code := strings.TrimSpace(text)
+		if code == "" {
+			return "", EmptyTextError
+		}
+		return code, nil
+	}
+	lang = strings.ToLower(lang)
+	for _, block := range blocks {
+		if lang == "" || block.Lang == lang {
+			return block.Code, nil
+		}
+	}
+	return "", fmt.Errorf("%w: %s", CodeNotFoundError, lang)
+}
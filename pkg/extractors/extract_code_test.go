@@ -0,0 +1,29 @@
+package extractors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExtractCode(t *testing.T) {
+	reply := "Sure!\n```text\nnotes\n```\n```gnd\n$a trim\n```\n"
+
+	got, err := ExtractCode(reply, "")
+	assert.NoError(t, err)
+	assert.Equal(t, "notes", got)
+
+	got, err = ExtractCode(reply, "GND")
+	assert.NoError(t, err)
+	assert.Equal(t, "$a trim", got)
+
+	_, err = ExtractCode(reply, "python")
+	assert.ErrorIs(t, err, CodeNotFoundError)
+
+	got, err = ExtractCode("\n  trim\n", "gnd")
+	assert.NoError(t, err)
+	assert.Equal(t, "trim", got)
+
+	_, err = ExtractCode(" \n ", "")
+	assert.ErrorIs(t, err, EmptyTextError)
+}
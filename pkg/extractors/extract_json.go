@@ -0,0 +1,105 @@
+package extractors
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+)
+
+var JsonNotFoundError = errors.New("no JSON object or array found")
+
+// ExtractJSON returns the first JSON object or array in text. Fenced code
+// blocks are searched first, blocks marked as json before others, and then
+// the whole text, so that JSON surrounded by prose is found as well.
+func ExtractJSON(text string) (string, error) {
+	blocks := FindCodeBlocks(text)
+	var candidates []string
+	for _, block := range blocks {
+		if block.Lang == "json" {
+			candidates = append(candidates, block.Code)
+		}
+	}
+	for _, block := range blocks {
+		if block.Lang != "json" {
+			candidates = append(candidates, block.Code)
+		}
+	}
+	candidates = append(candidates, text)
+
+	for _, candidate := range candidates {
+		if raw, ok := findJSON(candidate); ok {
+			return raw, nil
+		}
+	}
+	return "", JsonNotFoundError
+}
+
+// findJSON returns the first complete JSON object or array in s. Brackets in
+// prose are skipped. A failed attempt resumes where the decoder gave up, so a
+// long reply with brackets that never close is scanned in linear time.
+func findJSON(s string) (string, bool) {
+	for start := 0; start < len(s); {
+		if s[start] != '{' && s[start] != '[' {
+			start++
+			continue
+		}
+		raw, next, ok := decodeJSONAt(s, start)
+		if ok {
+			return raw, true
+		}
+		start = next
+	}
+	return "", false
+}
+
+// decodeJSONAt reads the object or array starting at s[start] token by
+// token. If it is incomplete or invalid, the first complete object or array
+// nested in it is returned instead, as decoding from its own start would read
+// the same tokens. Otherwise next is the offset to continue searching from:
+// the byte the decoder failed at, the end of s if the value is unterminated,
+// or the next byte after any other error.
+func decodeJSONAt(s string, start int) (raw string, next int, ok bool) {
+	decoder := json.NewDecoder(strings.NewReader(s[start:]))
+	// Numbers are not converted, so one out of the range of float64 is valid
+	decoder.UseNumber()
+	var opened []int64
+	first, firstEnd := int64(-1), int64(-1)
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			if first >= 0 {
+				return s[start+int(first) : start+int(firstEnd)], 0, true
+			}
+			var syntaxErr *json.SyntaxError
+			switch {
+			case errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF):
+				// The rest of s is the beginning of an unterminated value
+				return "", len(s), false
+			case errors.As(err, &syntaxErr):
+				// Offset counts the invalid byte, which may start the next value
+				return "", start + max(int(syntaxErr.Offset)-1, 1), false
+			default:
+				return "", start + 1, false
+			}
+		}
+		delim, isDelim := token.(json.Delim)
+		if !isDelim {
+			continue
+		}
+		offset := decoder.InputOffset()
+		switch delim {
+		case '{', '[':
+			opened = append(opened, offset-1)
+		default:
+			open := opened[len(opened)-1]
+			opened = opened[:len(opened)-1]
+			if len(opened) == 0 {
+				return s[start : start+int(offset)], 0, true
+			}
+			if first < 0 || open < first {
+				first, firstEnd = open, offset
+			}
+		}
+	}
+}
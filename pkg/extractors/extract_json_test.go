@@ -0,0 +1,95 @@
+package extractors
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExtractJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{
+			name:  "bare object",
+			input: `{"a": 1}`,
+			want:  `{"a": 1}`,
+		},
+		{
+			name:  "object in prose",
+			input: "The result is {\"ok\": true} as requested.",
+			want:  `{"ok": true}`,
+		},
+		{
+			name:  "array in prose with brackets before it",
+			input: "Options [see below]: [1, 2, {\"x\": \"]\"}] done",
+			want:  `[1, 2, {"x": "]"}]`,
+		},
+		{
+			name:  "json block before other blocks",
+			input: "```\n[1]\n```\n```json\n{\"b\": 2}\n```",
+			want:  `{"b": 2}`,
+		},
+		{
+			name:  "unlabelled block before prose",
+			input: "Not this {\"a\": 1}\n```\n{\"b\": 2}\n```",
+			want:  `{"b": 2}`,
+		},
+		{
+			name:  "complete object in a truncated array",
+			input: `Result: [1, {"a": 1}, {"b": `,
+			want:  `{"a": 1}`,
+		},
+		{
+			name:  "object after an invalid object start",
+			input: `{ {"a": 1} }`,
+			want:  `{"a": 1}`,
+		},
+		{
+			name:  "number out of the range of float64",
+			input: `{"a": 1e400} and {"b": 2}`,
+			want:  `{"a": 1e400}`,
+		},
+		{
+			name:  "number out of the range of float64 in prose",
+			input: `[1e400 and {"b": 2}`,
+			want:  `{"b": 2}`,
+		},
+		{
+			name:  "invalid block falls back to the text",
+			input: "```json\n{broken\n```\nretry: {\"c\": 3}",
+			want:  `{"c": 3}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ExtractJSON(tt.input)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	_, err := ExtractJSON("no json { here")
+	assert.ErrorIs(t, err, JsonNotFoundError)
+	_, err = ExtractJSON(`"just a string" 42`)
+	assert.ErrorIs(t, err, JsonNotFoundError)
+}
+
+func TestExtractJSONUnbalancedBrackets(t *testing.T) {
+	inputs := []string{
+		strings.Repeat("[", 100000),
+		strings.Repeat("{[", 50000),
+		strings.Repeat("[1, ", 50000),
+		strings.Repeat("{x [y ", 50000),
+	}
+	for _, input := range inputs {
+		started := time.Now()
+		_, err := ExtractJSON("Here you go: " + input)
+		assert.ErrorIs(t, err, JsonNotFoundError)
+		assert.Less(t, time.Since(started), 10*time.Second, input[:8])
+	}
+}
@@ -0,0 +1,45 @@
+package extractors
+
+import (
+	"strings"
+)
+
+// ExtractList returns the items of the first Markdown list in text. Items
+// start with "-", "*" or "+", or with a number followed by "." or ")".
+// Indented lines continue the previous item, and items of nested lists are
+// included in order. The list ends at the first line that is neither blank,
+// indented nor an item. A text without a list has no items.
+func ExtractList(text string) []string {
+	var items []string
+	for _, line := range strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n") {
+		trimmed := strings.TrimSpace(line)
+		if item, ok := listItem(trimmed); ok {
+			items = append(items, item)
+			continue
+		}
+		if len(items) == 0 || trimmed == "" {
+			continue
+		}
+		if line[0] == ' ' || line[0] == '\t' {
+			items[len(items)-1] = strings.TrimSpace(items[len(items)-1] + " " + trimmed)
+			continue
+		}
+		break
+	}
+	return items
+}
+
+// listItem returns the text of a list item line without its marker
+func listItem(line string) (string, bool) {
+	if len(line) >= 2 && strings.ContainsRune("-*+", rune(line[0])) && (line[1] == ' ' || line[1] == '\t') {
+		return strings.TrimSpace(line[2:]), true
+	}
+	digits := 0
+	for digits < len(line) && digits < 9 && line[digits] >= '0' && line[digits] <= '9' {
+		digits++
+	}
+	if digits > 0 && digits+1 < len(line) && (line[digits] == '.' || line[digits] == ')') && (line[digits+1] == ' ' || line[digits+1] == '\t') {
+		return strings.TrimSpace(line[digits+2:]), true
+	}
+	return "", false
+}
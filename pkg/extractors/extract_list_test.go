@@ -0,0 +1,56 @@
+package extractors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExtractList(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []string
+	}{
+		{
+			name:  "bullets after prose",
+			input: "Here are the ideas:\n- first\n* second\n+ third\n\nLet me know!",
+			want:  []string{"first", "second", "third"},
+		},
+		{
+			name:  "numbered items",
+			input: "1. one\n2) two\n10. ten",
+			want:  []string{"one", "two", "ten"},
+		},
+		{
+			name:  "continuation lines",
+			input: "- a long\n  item\n- short",
+			want:  []string{"a long item", "short"},
+		},
+		{
+			name:  "nested items",
+			input: "- a\n  - b\n- c",
+			want:  []string{"a", "b", "c"},
+		},
+		{
+			name:  "blank lines between items",
+			input: "1. a\n\n2. b\r\n",
+			want:  []string{"a", "b"},
+		},
+		{
+			name:  "only the first list",
+			input: "- a\nprose\n- b",
+			want:  []string{"a"},
+		},
+		{
+			name:  "not list items",
+			input: "---\n**bold**\n3.14 is pi\n-1 degrees",
+			want:  nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ExtractList(tt.input))
+		})
+	}
+}
@@ -1,9 +1,11 @@
 package interpreters
 
 import (
+	"context"
 	"fmt"
 	"io/fs"
-	"os"
+	"path"
+	"regexp"
 	"strings"
 
 	"github.com/hyperifyio/gnd/pkg/embedded_routines"
@@ -13,6 +15,8 @@ import (
 	"github.com/hyperifyio/gnd/pkg/primitive_services"
 	"github.com/hyperifyio/gnd/pkg/primitive_types"
 	"github.com/hyperifyio/gnd/pkg/primitives"
+	"github.com/hyperifyio/gnd/pkg/runtime_errors"
+	"github.com/hyperifyio/gnd/pkg/units"
 )
 
 // InterpreterImpl represents the execution environment
@@ -23,6 +27,8 @@ type InterpreterImpl struct {
 	LogIndent   int                         // Current log indentation level
 	UnitsFS     fs.FS                       // Filesystem containing embedded GND routines
 	OpcodeMap   map[string]string           // Map of opcode aliases
+	Regexps     *RegexpCache                // Compiled patterns, shared with children
+	Ctx         context.Context             // Done when the routine is cancelled
 	parent      primitive_types.Interpreter // Parent interpreter for nested calls
 }
 
@@ -38,15 +44,22 @@ func NewInterpreter(
 		LogIndent:   0,
 		UnitsFS:     embedded_routines.GetEmbeddedRoutinesFS(),
 		OpcodeMap:   opcodeMap,
+		Regexps:     NewRegexpCache(),
+		Ctx:         context.Background(),
 	}
 }
 
-// NewInterpreterWithParent creates a new interpreter with initial slots and opcode aliases
+// NewInterpreterWithParent creates a new interpreter with initial slots and opcode aliases.
+// The new interpreter runs with the context of the parent.
 func NewInterpreterWithParent(
 	scriptDir string,
 	initialSlots map[string]interface{},
 	parent primitive_types.Interpreter,
 ) primitive_types.Interpreter {
+	ctx := context.Background()
+	if parent != nil {
+		ctx = parent.GetContext()
+	}
 	return &InterpreterImpl{
 		Slots:       initialSlots,
 		Subroutines: make(map[string][]*parsers.Instruction),
@@ -54,6 +67,7 @@ func NewInterpreterWithParent(
 		LogIndent:   0,
 		UnitsFS:     embedded_routines.GetEmbeddedRoutinesFS(),
 		OpcodeMap:   make(map[string]string),
+		Ctx:         ctx,
 		parent:      parent,
 	}
 }
@@ -84,6 +98,14 @@ func (i *InterpreterImpl) GetScriptDir() string {
 	return i.ScriptDir
 }
 
+// GetContext returns the context of the routine
+func (i *InterpreterImpl) GetContext() context.Context {
+	if i.Ctx == nil {
+		return context.Background()
+	}
+	return i.Ctx
+}
+
 // GetLogIndent returns the current log indentation level
 func (i *InterpreterImpl) GetLogIndent() int {
 	return i.LogIndent
@@ -126,31 +148,24 @@ func (i *InterpreterImpl) LogError(format string, args ...interface{}) {
 	loggers.Printf(loggers.Error, prefix+format, args...)
 }
 
-// LoadSubroutine loads a subroutine from a file
+// LoadSubroutine loads a subroutine from a file, concatenating all fragments of the unit
 func (i *InterpreterImpl) LoadSubroutine(subPath string) error {
 
-	var content []byte
+	var instructions []*parsers.Instruction
+	var err error
 
 	if strings.HasPrefix(subPath, "/gnd/") {
-		// Parse subroutine file from embedded filesystem i.UnitsFS
-		c, err := fs.ReadFile(i.UnitsFS, subPath[1:])
+		// Load subroutine fragments from embedded filesystem i.UnitsFS
+		instructions, err = units.LoadUnitFS(i.UnitsFS, path.Dir(subPath[1:]), path.Base(subPath), path.Dir(subPath))
 		if err != nil {
-			return fmt.Errorf("[%s]: LoadSubroutine: failed to read embedded subroutine:\n  %v", subPath, err)
+			return fmt.Errorf("[%s]: LoadSubroutine: failed to read embedded subroutine:\n  %w", subPath, err)
 		}
-		content = c
 	} else {
-		// Read the subroutine file from filesystem
-		c, err := os.ReadFile(subPath)
+		// Load subroutine fragments from filesystem
+		instructions, err = units.LoadUnitFile(subPath)
 		if err != nil {
-			return fmt.Errorf("[%s]: LoadSubroutine: failed to read subroutine:\n  %v", subPath, err)
+			return fmt.Errorf("[%s]: LoadSubroutine: failed to read subroutine:\n  %w", subPath, err)
 		}
-		content = c
-	}
-
-	// Parse the subroutine file
-	instructions, err := parsers.ParseInstructionLines(subPath, string(content))
-	if err != nil {
-		return fmt.Errorf("[%s]: LoadSubroutine: failed to parse subroutine:\n  %v", subPath, err)
 	}
 
 	// Store the subroutine
@@ -161,10 +176,17 @@ func (i *InterpreterImpl) LoadSubroutine(subPath string) error {
 // ExecuteInstructionBlock executes a sequence of instructions and returns the last result
 func (i *InterpreterImpl) ExecuteInstructionBlock(source string, input interface{}, instructions []*parsers.Instruction) (interface{}, error) {
 	lastResult := input
+	ctx := i.GetContext()
 	for idx, op := range instructions {
 		if op != nil {
 			i.LogDebug("[%s:%d]: ExecuteInstructionBlock: %v <- %s %v", source, idx, op.Destination, op.Opcode, op.Arguments)
 
+			// Stop a cancelled routine before its next instruction
+			if err := ctx.Err(); err != nil {
+				i.LogDebug("[%s:%d]: ExecuteInstructionBlock: stopped: %v", source, idx, err)
+				return nil, i.instructionError(source, idx, op, op.Opcode, runtime_errors.NewContextError(err))
+			}
+
 			// Check if the opcode exists in the default alias map
 			var opcode = i.ResolveOpcode(op.Opcode)
 			var arguments = op.Arguments
@@ -174,7 +196,7 @@ func (i *InterpreterImpl) ExecuteInstructionBlock(source string, input interface
 			resolvedArgs, err := i.LoadArguments(opcode, arguments)
 			if err != nil {
 				i.LogDebug("[%s]: ExecuteInstructionBlock: argument parsing failed: %v <- %s %v: error: %v", opcode, destination, opcode, resolvedArgs, err)
-				return nil, fmt.Errorf("[%s]: ExecuteInstructionBlock: failed to load arguments: %s", opcode, err)
+				return nil, i.instructionError(source, idx, op, opcode, fmt.Errorf("[%s]: ExecuteInstructionBlock: failed to load arguments: %s", opcode, err))
 			}
 			i.LogDebug("[%s]: ExecuteInstructionBlock: Resolved arguments as: %v from %v", opcode, resolvedArgs, arguments)
 
@@ -186,13 +208,17 @@ func (i *InterpreterImpl) ExecuteInstructionBlock(source string, input interface
 
 				if err != nil {
 					i.LogDebug("[%s]: ExecuteInstructionBlock: subroutine had error: %v <- %s %v: error: %v", opcode, destination, opcode, resolvedArgs, err)
-					return nil, fmt.Errorf("\n  %s:%d: %v", source, idx, err)
+					return nil, i.instructionError(source, idx, op, opcode, err)
 				}
 
 			} else {
 				i.LogDebug("[%s]: ExecuteInstructionBlock: primitive: %v <- %s %v", opcode, destination, opcode, resolvedArgs)
 
-				result, err = prim.Execute(resolvedArgs)
+				if contextPrim, ok2 := prim.(primitive_types.ContextPrimitive); ok2 {
+					result, err = contextPrim.ExecuteWithContext(ctx, resolvedArgs)
+				} else {
+					result, err = prim.Execute(resolvedArgs)
+				}
 
 				if err != nil {
 
@@ -206,7 +232,7 @@ func (i *InterpreterImpl) ExecuteInstructionBlock(source string, input interface
 						result, err = handler.HandleBlockErrorResult(err, i, destination, instructions)
 						if err != nil {
 							i.LogDebug("[%s]: ExecuteInstructionBlock: handler had error: %v <- %s %v: error: %v", opcode, destination, opcode, resolvedArgs, err)
-							return nil, fmt.Errorf("\n  %s:%d: %v", source, idx, err)
+							return nil, i.instructionError(source, idx, op, opcode, err)
 						}
 
 						if returnValue, ok2 := primitives.GetReturnValue(result); ok2 {
@@ -218,7 +244,7 @@ func (i *InterpreterImpl) ExecuteInstructionBlock(source string, input interface
 
 					} else {
 						i.LogDebug("[%s]: ExecuteInstructionBlock: no handler detected: %v <- %s %v: error: %v", opcode, destination, opcode, resolvedArgs, err)
-						return nil, fmt.Errorf("\n  %s:%d: %v", source, idx, err)
+						return nil, i.instructionError(source, idx, op, opcode, err)
 					}
 
 				} else {
@@ -229,7 +255,7 @@ func (i *InterpreterImpl) ExecuteInstructionBlock(source string, input interface
 						result, err = handler.HandleBlockSuccessResult(result, i, destination, instructions)
 						if err != nil {
 							i.LogDebug("[%s]: ExecuteInstructionBlock: BlockSuccessResultHandler failed: %v <- %s %v: error: %v", opcode, destination, opcode, resolvedArgs, err)
-							return nil, fmt.Errorf("\n  %s:%d: %v", source, idx, err)
+							return nil, i.instructionError(source, idx, op, opcode, err)
 						}
 						i.LogDebug("[%s]: ExecuteInstructionBlock: we got result: %v <- %s %v: %v", opcode, destination, opcode, resolvedArgs, result)
 					} else {
@@ -250,6 +276,21 @@ func (i *InterpreterImpl) ExecuteInstructionBlock(source string, input interface
 	return lastResult, nil
 }
 
+// instructionError records the failing instruction in the call stack of err
+func (i *InterpreterImpl) instructionError(source string, idx int, op *parsers.Instruction, opcode string, err error) error {
+	unit := op.File
+	if unit == "" {
+		unit = source
+	}
+	return runtime_errors.WithFrame(err, runtime_errors.Frame{
+		Unit:   unit,
+		Line:   op.Line,
+		Column: op.Column,
+		Opcode: opcode,
+		Index:  idx,
+	})
+}
+
 // GetSubroutineInstructions retrieves the cached instructions for a subroutine
 func (i *InterpreterImpl) GetSubroutineInstructions(path string) ([]*parsers.Instruction, error) {
 	instructions, ok := i.Subroutines[path]
@@ -293,12 +334,28 @@ func (i *InterpreterImpl) ExecuteSubroutine(name string, args []interface{}) (in
 	// Execute the instructions using the new method
 	result, err2 := subInterpreter.ExecuteInstructionBlock(subPath, args, instructions)
 	if err2 != nil {
-		return nil, fmt.Errorf("[%s]: ExecuteSubroutine: execute failed: %v", name, err2)
+		if _, ok := runtime_errors.GetGndError(err2); ok {
+			// The caller adds its own frame to the stack
+			return nil, err2
+		}
+		return nil, fmt.Errorf("[%s]: ExecuteSubroutine: execute failed: %w", name, err2)
 	}
 	i.LogDebug("[%s]: result: %v", name, result)
 	return result, nil
 }
 
+// CompileRegexp compiles an RE2 pattern using the cache of the root
+// interpreter
+func (i *InterpreterImpl) CompileRegexp(pattern string) (*regexp.Regexp, error) {
+	if i.parent != nil {
+		return i.parent.CompileRegexp(pattern)
+	}
+	if i.Regexps == nil {
+		return regexp.Compile(pattern)
+	}
+	return i.Regexps.Compile(pattern)
+}
+
 // ResolveOpcode resolves the opcode to its mapped value
 func (i *InterpreterImpl) ResolveOpcode(opcode string) string {
 	if mapped, exists := i.OpcodeMap[opcode]; exists {
@@ -336,7 +393,10 @@ func (i *InterpreterImpl) ExecuteSubroutineCall(opcode string, destination *pars
 	// Execute the subroutine with the resolved arguments
 	result, err := i.ExecuteSubroutine(opcode, arguments)
 	if err != nil {
-		return nil, fmt.Errorf("[%s]: ExecuteSubroutineCall: error: %s", opcode, err)
+		if _, ok := runtime_errors.GetGndError(err); ok {
+			return nil, err
+		}
+		return nil, fmt.Errorf("[%s]: ExecuteSubroutineCall: error: %w", opcode, err)
 	}
 
 	// Store the result in the destination slot
@@ -352,3 +412,14 @@ func (i *InterpreterImpl) NewInterpreterWithParent(
 ) primitive_types.Interpreter {
 	return NewInterpreterWithParent(scriptDir, initialSlots, i)
 }
+
+// NewInterpreterWithContext creates a new child interpreter that runs with ctx
+func (i *InterpreterImpl) NewInterpreterWithContext(
+	ctx context.Context,
+	scriptDir string,
+	initialSlots map[string]interface{},
+) primitive_types.Interpreter {
+	child := NewInterpreterWithParent(scriptDir, initialSlots, i).(*InterpreterImpl)
+	child.Ctx = ctx
+	return child
+}
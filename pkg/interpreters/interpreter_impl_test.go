@@ -1,14 +1,19 @@
 package interpreters_test
 
 import (
+	"context"
 	"os"
 	"path/filepath"
 
 	"github.com/hyperifyio/gnd/pkg/interpreters"
 	"github.com/hyperifyio/gnd/pkg/parsers"
+	"github.com/hyperifyio/gnd/pkg/primitive_services"
+	"github.com/hyperifyio/gnd/pkg/primitives"
+	"github.com/hyperifyio/gnd/pkg/runtime_errors"
 
 	"github.com/stretchr/testify/assert"
 	"testing"
+	"time"
 )
 
 func TestNewInterpreter(t *testing.T) {
@@ -54,8 +59,10 @@ func TestLoadSubroutine(t *testing.T) {
 	testFiles := map[string]string{
 		"math.gnd": `add _ [1 2]
 subtract _ [5 3]`,
-		"string.gnd": `concat _ ["hello" "world"]`,
-		"add.gnd":    `add _ []`,
+		"string.gnd":  `concat _ ["hello" "world"]`,
+		"add.gnd":     `add _ []`,
+		"010-sum.gnd": `concat _ ["a"]`,
+		"sum-1.gnd":   `concat _ ["b"]`,
 	}
 
 	for name, content := range testFiles {
@@ -79,16 +86,51 @@ subtract _ [5 3]`,
 					Destination: parsers.NewPropertyRef("_"),
 					Arguments: []interface{}{
 						parsers.NewPropertyRef("_"),
-						[]interface{}{"1", "2"},
+						[]interface{}{int64(1), int64(2)},
 					},
+					File:   filepath.Join(tempDir, "math.gnd"),
+					Line:   1,
+					Column: 1,
 				},
 				{
 					Opcode:      "subtract",
 					Destination: parsers.NewPropertyRef("_"),
 					Arguments: []interface{}{
 						parsers.NewPropertyRef("_"),
-						[]interface{}{"5", "3"},
+						[]interface{}{int64(5), int64(3)},
 					},
+					File:   filepath.Join(tempDir, "math.gnd"),
+					Line:   2,
+					Column: 1,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name:    "load fragmented unit",
+			subPath: filepath.Join(tempDir, "sum.gnd"),
+			want: []*parsers.Instruction{
+				{
+					Opcode:      "concat",
+					Destination: parsers.NewPropertyRef("_"),
+					Arguments: []interface{}{
+						parsers.NewPropertyRef("_"),
+						[]interface{}{"a"},
+					},
+					File:   filepath.Join(tempDir, "010-sum.gnd"),
+					Line:   1,
+					Column: 1,
+				},
+				{
+					Opcode:      "concat",
+					Destination: parsers.NewPropertyRef("_"),
+					Arguments: []interface{}{
+						parsers.NewPropertyRef("_"),
+						[]interface{}{"b"},
+					},
+					File:   filepath.Join(tempDir, "sum-1.gnd"),
+					Line:   1,
+					Column: 1,
 				},
 			},
 			wantErr: false,
@@ -124,3 +166,82 @@ subtract _ [5 3]`,
 		})
 	}
 }
+
+func TestExecuteInstructionBlockErrorTrace(t *testing.T) {
+	tempDir := t.TempDir()
+	testFiles := map[string]string{
+		"inner.gnd": "$y concat _\n\n  throw \"boom\" $y\n",
+		"outer.gnd": "$x let 1\ninner $x\n",
+	}
+	for name, content := range testFiles {
+		assert.NoError(t, os.WriteFile(filepath.Join(tempDir, name), []byte(content), 0644))
+	}
+
+	outer := filepath.Join(tempDir, "outer.gnd")
+	interpreter := interpreters.NewInterpreter(tempDir, primitive_services.GetDefaultOpcodeMap()).(*interpreters.InterpreterImpl)
+	assert.NoError(t, interpreter.LoadSubroutine(outer))
+	instructions := interpreter.Subroutines[outer]
+
+	_, err := interpreter.ExecuteInstructionBlock(outer, nil, instructions)
+	assert.Error(t, err)
+
+	gndErr, ok := runtime_errors.GetGndError(err)
+	assert.True(t, ok)
+	assert.Equal(t, []runtime_errors.Frame{
+		{Unit: filepath.Join(tempDir, "inner.gnd"), Line: 3, Column: 3, Opcode: "/gnd/throw", Index: 1},
+		{Unit: outer, Line: 2, Column: 1, Opcode: "inner", Index: 1},
+	}, gndErr.Frames)
+	assert.Contains(t, gndErr.Traceback(), "Traceback (most recent call last):\n  "+outer+":2:1: inner\n")
+}
+
+func TestExecuteInstructionBlockContext(t *testing.T) {
+	interpreter := interpreters.NewInterpreter(t.TempDir(), primitive_services.GetDefaultOpcodeMap())
+	assert.Equal(t, context.Background(), interpreter.GetContext())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	child := interpreter.NewInterpreterWithContext(ctx, interpreter.GetScriptDir(), map[string]interface{}{})
+	grandchild := child.NewInterpreterWithParent(child.GetScriptDir(), map[string]interface{}{})
+	assert.Equal(t, ctx, grandchild.GetContext())
+
+	instructions, err := parsers.ParseInstructionLines("test.gnd", "$x let 1\n$y wait 5000\n")
+	assert.NoError(t, err)
+	cancel()
+	_, err = grandchild.ExecuteInstructionBlock("test.gnd", nil, instructions)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, runtime_errors.CancelledErrorCode, runtime_errors.ToErrorValue(err).Code)
+
+	// The cancelled routine stops before its first instruction
+	_, err = grandchild.GetSlot("x")
+	assert.Error(t, err)
+
+	// A wait that is already running returns early
+	ctx, cancel = context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	child = interpreter.NewInterpreterWithContext(ctx, interpreter.GetScriptDir(), map[string]interface{}{})
+	_, err = child.ExecuteInstructionBlock("test.gnd", nil, instructions)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Equal(t, runtime_errors.TimeoutErrorCode, runtime_errors.ToErrorValue(err).Code)
+	x, err := child.GetSlot("x")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), x)
+}
+
+func TestExecuteInstructionBlockAsyncDestination(t *testing.T) {
+	interpreter := interpreters.NewInterpreter(t.TempDir(), primitive_services.GetDefaultOpcodeMap())
+	routine, err := parsers.ParseInstructionLines("routine", "concat *_\n")
+	assert.NoError(t, err)
+	assert.NoError(t, interpreter.SetSlot("r", routine))
+
+	instructions, err := parsers.ParseInstructionLines("test.gnd", "$task async $r \"a\" \"b\"\n$x let 1\n$result await $task\n")
+	assert.NoError(t, err)
+	_, err = interpreter.ExecuteInstructionBlock("test.gnd", nil, instructions)
+	assert.NoError(t, err)
+
+	// async binds the task to its destination, not only to _
+	task, err := interpreter.GetSlot("task")
+	assert.NoError(t, err)
+	assert.IsType(t, &primitives.Task{}, task)
+	result, err := interpreter.GetSlot("result")
+	assert.NoError(t, err)
+	assert.Equal(t, "ab", result)
+}
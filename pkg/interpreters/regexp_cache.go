@@ -0,0 +1,48 @@
+package interpreters
+
+import (
+	"regexp"
+	"sync"
+)
+
+// MaxCachedRegexps limits the number of patterns in a RegexpCache. The cache
+// is emptied when it is full, so generated patterns cannot grow it forever.
+var MaxCachedRegexps = 256
+
+// RegexpCache holds compiled regular expressions by pattern. It is safe for
+// concurrent use, as async tasks share the cache of their interpreter.
+type RegexpCache struct {
+	mu       sync.Mutex
+	patterns map[string]*regexp.Regexp
+}
+
+// NewRegexpCache creates an empty cache
+func NewRegexpCache() *RegexpCache {
+	return &RegexpCache{patterns: make(map[string]*regexp.Regexp)}
+}
+
+// Compile returns the compiled pattern, compiling it on first use. Invalid
+// patterns are not cached.
+func (c *RegexpCache) Compile(pattern string) (*regexp.Regexp, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if re, ok := c.patterns[pattern]; ok {
+		return re, nil
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+	if len(c.patterns) >= MaxCachedRegexps {
+		c.patterns = make(map[string]*regexp.Regexp)
+	}
+	c.patterns[pattern] = re
+	return re, nil
+}
+
+// Len returns the number of cached patterns
+func (c *RegexpCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.patterns)
+}
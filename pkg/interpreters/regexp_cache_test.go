@@ -0,0 +1,42 @@
+package interpreters_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hyperifyio/gnd/pkg/interpreters"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegexpCache(t *testing.T) {
+	cache := interpreters.NewRegexpCache()
+
+	first, err := cache.Compile("a+")
+	assert.NoError(t, err)
+	second, err := cache.Compile("a+")
+	assert.NoError(t, err)
+	assert.Same(t, first, second)
+	assert.Equal(t, 1, cache.Len())
+
+	_, err = cache.Compile("(")
+	assert.Error(t, err)
+	assert.Equal(t, 1, cache.Len())
+
+	for n := 0; n < interpreters.MaxCachedRegexps; n++ {
+		_, err := cache.Compile(fmt.Sprintf("a{%d}", n))
+		assert.NoError(t, err)
+	}
+	assert.LessOrEqual(t, cache.Len(), interpreters.MaxCachedRegexps)
+}
+
+func TestCompileRegexpSharedWithChildren(t *testing.T) {
+	parent := interpreters.NewInterpreter("", make(map[string]string))
+	child := parent.NewInterpreterWithParent("", map[string]interface{}{})
+
+	first, err := parent.CompileRegexp("[0-9]+")
+	assert.NoError(t, err)
+	second, err := child.CompileRegexp("[0-9]+")
+	assert.NoError(t, err)
+	assert.Same(t, first, second)
+	assert.Equal(t, 1, parent.(*interpreters.InterpreterImpl).Regexps.Len())
+}
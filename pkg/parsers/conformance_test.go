@@ -0,0 +1,52 @@
+package parsers
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const conformanceDir = "testdata/conformance"
+
+func TestConformanceValid(t *testing.T) {
+	files, err := filepath.Glob(filepath.Join(conformanceDir, "valid", "*.gnd"))
+	assert.NoError(t, err)
+	assert.NotEmpty(t, files)
+
+	for _, file := range files {
+		t.Run(filepath.Base(file), func(t *testing.T) {
+			content, err := os.ReadFile(file)
+			assert.NoError(t, err)
+
+			_, err = ParseInstructionLinesStrict(file, string(content))
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestConformanceInvalid(t *testing.T) {
+	files, err := filepath.Glob(filepath.Join(conformanceDir, "invalid", "*.gnd"))
+	assert.NoError(t, err)
+	assert.NotEmpty(t, files)
+
+	for _, file := range files {
+		t.Run(filepath.Base(file), func(t *testing.T) {
+			content, err := os.ReadFile(file)
+			assert.NoError(t, err)
+			expected, err := os.ReadFile(strings.TrimSuffix(file, ".gnd") + ".err")
+			assert.NoError(t, err)
+
+			_, err = ParseInstructionLinesStrict(file, string(content))
+			var syntaxErr *SyntaxError
+			if assert.True(t, errors.As(err, &syntaxErr), "expected a syntax error, got %v", err) {
+				assert.Equal(t, strings.TrimSpace(string(expected)), fmt.Sprintf("%d:%d", syntaxErr.Line, syntaxErr.Column), syntaxErr.Error())
+				assert.Equal(t, file, syntaxErr.Source)
+			}
+		})
+	}
+}
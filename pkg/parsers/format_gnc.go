@@ -0,0 +1,141 @@
+package parsers
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	// GncMagic is the first token of every .gnc file
+	GncMagic = "gnc"
+	// GncVersion is the version of the .gnc format written by FormatGnc
+	GncVersion = 1
+)
+
+var (
+	GncUnsupportedValueError = errors.New("gnc: unsupported value type")
+	GncInvalidOpcodeError    = errors.New("gnc: opcode must be a non-empty token without whitespace")
+)
+
+// FormatGnc serializes instructions into the compact .gnc text format.
+// Opcodes are written as-is; callers resolve aliases before formatting.
+func FormatGnc(instructions []*Instruction) (string, error) {
+	var b strings.Builder
+	b.WriteString(GncMagic + " " + strconv.Itoa(GncVersion) + "\n")
+	for idx, op := range instructions {
+		if op == nil {
+			continue
+		}
+		line, err := FormatGncInstruction(op)
+		if err != nil {
+			return "", fmt.Errorf("instruction %d: %w", idx, err)
+		}
+		b.WriteString(line)
+		b.WriteString("\n")
+	}
+	return b.String(), nil
+}
+
+// FormatGncInstruction serializes a single instruction as one .gnc line
+func FormatGncInstruction(op *Instruction) (string, error) {
+	if op.Opcode == "" || strings.ContainsAny(op.Opcode, " \t\r\n\"[]{}") {
+		return "", fmt.Errorf("%w: %q", GncInvalidOpcodeError, op.Opcode)
+	}
+	destination := op.Destination
+	if destination == nil {
+		destination = NewPropertyRef("_")
+	}
+	parts := []string{formatGncPropertyRef(destination), op.Opcode}
+	for _, arg := range op.Arguments {
+		str, err := FormatGncValue(arg)
+		if err != nil {
+			return "", err
+		}
+		parts = append(parts, str)
+	}
+	return strings.Join(parts, " "), nil
+}
+
+// FormatGncValue serializes a single argument value with an explicit type
+func FormatGncValue(arg interface{}) (string, error) {
+	switch v := arg.(type) {
+	case nil:
+		return "nil", nil
+	case *PropertyRef:
+		return formatGncPropertyRef(v), nil
+	case string:
+		return strconv.Quote(v), nil
+	case bool:
+		return "bool:" + strconv.FormatBool(v), nil
+	case int:
+		return "int:" + strconv.FormatInt(int64(v), 10), nil
+	case int8:
+		return "int8:" + strconv.FormatInt(int64(v), 10), nil
+	case int16:
+		return "int16:" + strconv.FormatInt(int64(v), 10), nil
+	case int32:
+		return "int32:" + strconv.FormatInt(int64(v), 10), nil
+	case int64:
+		return "int64:" + strconv.FormatInt(v, 10), nil
+	case uint:
+		return "uint:" + strconv.FormatUint(uint64(v), 10), nil
+	case uint8:
+		return "uint8:" + strconv.FormatUint(uint64(v), 10), nil
+	case uint16:
+		return "uint16:" + strconv.FormatUint(uint64(v), 10), nil
+	case uint32:
+		return "uint32:" + strconv.FormatUint(uint64(v), 10), nil
+	case uint64:
+		return "uint64:" + strconv.FormatUint(v, 10), nil
+	case float32:
+		return "float32:" + strconv.FormatFloat(float64(v), 'g', -1, 32), nil
+	case float64:
+		return "float64:" + strconv.FormatFloat(v, 'g', -1, 64), nil
+	case []interface{}:
+		parts := []string{"["}
+		for _, item := range v {
+			str, err := FormatGncValue(item)
+			if err != nil {
+				return "", err
+			}
+			parts = append(parts, str)
+		}
+		parts = append(parts, "]")
+		return strings.Join(parts, " "), nil
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		parts := []string{"{"}
+		for _, key := range keys {
+			str, err := FormatGncValue(v[key])
+			if err != nil {
+				return "", err
+			}
+			parts = append(parts, strconv.Quote(key), str)
+		}
+		parts = append(parts, "}")
+		return strings.Join(parts, " "), nil
+	default:
+		return "", fmt.Errorf("%w: %T", GncUnsupportedValueError, arg)
+	}
+}
+
+// formatGncPropertyRef serializes a property reference using the .gnd syntax
+func formatGncPropertyRef(ref *PropertyRef) string {
+	switch {
+	case ref.Name == "_" && ref.Spread:
+		return "*_"
+	case ref.Name == "_":
+		return "_"
+	case ref.Spread:
+		return "$*" + ref.Name
+	default:
+		return "$" + ref.Name
+	}
+}
@@ -0,0 +1,34 @@
+package parsers
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatGnc(t *testing.T) {
+	instructions := []*Instruction{
+		NewInstruction("/gnd/let", NewPropertyRef("x"), []interface{}{"Hello \"World\"\n"}),
+		NewInstruction("/gnd/concat", NewPropertyRef("_"), []interface{}{NewPropertyRef("x"), NewSpreadPropertyRef("_"), NewSpreadPropertyRef("y")}),
+		NewInstruction("/gnd/let", NewPropertyRef("n"), []interface{}{int64(-5), uint8(7), 1.5, true, nil}),
+		NewInstruction("/gnd/let", NewPropertyRef("a"), []interface{}{[]interface{}{"a", []interface{}{}}, map[string]interface{}{"b": int(2), "a": "x"}}),
+	}
+
+	got, err := FormatGnc(instructions)
+	assert.NoError(t, err)
+	assert.Equal(t, `gnc 1
+$x /gnd/let "Hello \"World\"\n"
+_ /gnd/concat $x *_ $*y
+$n /gnd/let int64:-5 uint8:7 float64:1.5 bool:true nil
+$a /gnd/let [ "a" [ ] ] { "a" "x" "b" int:2 }
+`, got)
+}
+
+func TestFormatGncErrors(t *testing.T) {
+	_, err := FormatGnc([]*Instruction{NewInstruction("let", NewPropertyRef("x"), []interface{}{struct{}{}})})
+	assert.True(t, errors.Is(err, GncUnsupportedValueError))
+
+	_, err = FormatGnc([]*Instruction{NewInstruction("bad op", NewPropertyRef("x"), nil)})
+	assert.True(t, errors.Is(err, GncInvalidOpcodeError))
+}
@@ -7,6 +7,9 @@ type Instruction struct {
 	Opcode      string
 	Destination *PropertyRef
 	Arguments   []interface{}
+	File        string // Source file the instruction was parsed from, if known
+	Line        int    // Line number in File, or 0 if unknown
+	Column      int    // Column of the first token on the line, or 0 if unknown
 }
 
 // NewInstruction creates a new Instruction with the given opcode, destination, and arguments
@@ -18,6 +21,15 @@ func NewInstruction(opcode string, destination *PropertyRef, arguments []interfa
 	}
 }
 
+// Position returns the "file:line" source position of the instruction, or an
+// empty string if it is not known
+func (i *Instruction) Position() string {
+	if i == nil || i.Line <= 0 {
+		return ""
+	}
+	return fmt.Sprintf("%s:%d", i.File, i.Line)
+}
+
 // String returns a string representation of the Instruction
 func (i *Instruction) String() string {
 	if i == nil {
@@ -0,0 +1,9 @@
+package parsers
+
+import "unicode"
+
+// IsControlCharacter returns true for Unicode control characters (category
+// Cc) other than tab, which the strict parser rejects.
+func IsControlCharacter(r rune) bool {
+	return r != '\t' && unicode.IsControl(r)
+}
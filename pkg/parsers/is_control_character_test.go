@@ -0,0 +1,27 @@
+package parsers
+
+import "testing"
+
+func TestIsControlCharacter(t *testing.T) {
+	tests := []struct {
+		input    rune
+		expected bool
+	}{
+		{'\t', false},
+		{' ', false},
+		{'a', false},
+		{'“', false},
+		{0x00, true},
+		{'\r', true},
+		{'\n', true},
+		{0x1b, true},
+		{0x7f, true},
+		{0x85, true},
+	}
+
+	for _, tt := range tests {
+		if got := IsControlCharacter(tt.input); got != tt.expected {
+			t.Errorf("IsControlCharacter(%U) = %v, want %v", tt.input, got, tt.expected)
+		}
+	}
+}
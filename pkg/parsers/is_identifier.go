@@ -0,0 +1,20 @@
+package parsers
+
+// IsIdentifier returns true if s matches the identifier grammar: an ASCII
+// letter followed by ASCII letters, digits, or hyphens.
+func IsIdentifier(s string) bool {
+	if len(s) == 0 || !isAsciiLetter(s[0]) {
+		return false
+	}
+	for i := 1; i < len(s); i++ {
+		c := s[i]
+		if !isAsciiLetter(c) && !(c >= '0' && c <= '9') && c != '-' {
+			return false
+		}
+	}
+	return true
+}
+
+func isAsciiLetter(c byte) bool {
+	return (c >= 'A' && c <= 'Z') || (c >= 'a' && c <= 'z')
+}
@@ -0,0 +1,31 @@
+package parsers
+
+import "testing"
+
+func TestIsIdentifier(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected bool
+	}{
+		{"x", true},
+		{"fullPrompt", true},
+		{"sort-by", true},
+		{"a1-b2", true},
+		{"", false},
+		{"1a", false},
+		{"-a", false},
+		{"_", false},
+		{"a_b", false},
+		{"a$b", false},
+		{"a@b", false},
+		{"é", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if got := IsIdentifier(tt.input); got != tt.expected {
+				t.Errorf("IsIdentifier(%q) = %v, want %v", tt.input, got, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,6 @@
+package parsers
+
+// IsMapEnd returns true if the character is '}'.
+func IsMapEnd(c byte) bool {
+	return c == '}'
+}
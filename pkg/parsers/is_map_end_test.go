@@ -0,0 +1,24 @@
+package parsers
+
+import "testing"
+
+// TestIsMapEnd tests the IsMapEnd function for '}' and other characters.
+func TestIsMapEnd(t *testing.T) {
+	tests := []struct {
+		input    byte
+		expected bool
+	}{
+		{'}', true},
+		{'a', false},
+		{'{', false},
+	}
+
+	for _, tt := range tests {
+		t.Run(string([]byte{tt.input}), func(t *testing.T) {
+			got := IsMapEnd(tt.input)
+			if got != tt.expected {
+				t.Errorf("IsMapEnd(%q) = %v, want %v", tt.input, got, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,6 @@
+package parsers
+
+// IsMapStart returns true if the character is '{'.
+func IsMapStart(c byte) bool {
+	return c == '{'
+}
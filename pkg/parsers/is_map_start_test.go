@@ -0,0 +1,24 @@
+package parsers
+
+import "testing"
+
+// TestIsMapStart tests the IsMapStart function for '{' and other characters.
+func TestIsMapStart(t *testing.T) {
+	tests := []struct {
+		input    byte
+		expected bool
+	}{
+		{'{', true},
+		{'a', false},
+		{'}', false},
+	}
+
+	for _, tt := range tests {
+		t.Run(string([]byte{tt.input}), func(t *testing.T) {
+			got := IsMapStart(tt.input)
+			if got != tt.expected {
+				t.Errorf("IsMapStart(%q) = %v, want %v", tt.input, got, tt.expected)
+			}
+		})
+	}
+}
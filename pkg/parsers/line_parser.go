@@ -3,15 +3,25 @@ package parsers
 import (
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
+	"unicode/utf8"
 )
 
-var UnexpectedEofError = errors.New("unexpected EOF")
+var (
+	UnexpectedEofError    = errors.New("unexpected EOF")
+	MapKeyInvalidError    = errors.New("map key must be a string")
+	MapKeyDuplicateError  = errors.New("duplicate map key")
+	MapValueMissingError  = errors.New("map key has no value")
+	UnterminatedMapError  = errors.New("unterminated map")
+	UnexpectedMapEndError = errors.New("unexpected map end character '}'")
+)
 
 // LineParser maintains the state for parsing a single line
 type LineParser struct {
-	line string
-	pos  int
+	line   string
+	pos    int
+	strict bool // strict enforces the lexical rules of the syntax specification
 }
 
 // NewLineParser creates a new parser for the given line
@@ -19,6 +29,27 @@ func NewLineParser(line string) *LineParser {
 	return &LineParser{line: line}
 }
 
+// NewStrictLineParser creates a new parser for the given line that rejects
+// anything the syntax specification does not allow. Errors are reported as
+// *SyntaxError with the column of the offending character. Identifiers are
+// canonicalized to lower case and an unescaped # ends the line.
+func NewStrictLineParser(line string) *LineParser {
+	return &LineParser{line: line, strict: true}
+}
+
+// syntaxError returns a *SyntaxError at pos in strict mode, or err otherwise
+func (p *LineParser) syntaxError(pos int, err error) error {
+	if !p.strict {
+		return err
+	}
+	return NewSyntaxError(p.line, pos, err)
+}
+
+// IsComment returns true if an unescaped # starts at the current position in strict mode
+func (p *LineParser) IsComment() bool {
+	return p.strict && !p.IsEOF() && IsHashtag(p.line[p.pos])
+}
+
 // IsEOF returns true if we've reached the end of the line
 func (p *LineParser) IsEOF() bool {
 	return p.pos >= len(p.line)
@@ -48,6 +79,19 @@ func (p *LineParser) ParseEscapeSequence() (string, error) {
 	}
 
 	c := p.line[p.pos]
+	if c == 'u' {
+		if p.pos+5 <= len(p.line) {
+			if code, err := strconv.ParseUint(p.line[p.pos+1:p.pos+5], 16, 32); err == nil {
+				p.pos += 5
+				return string(rune(code)), nil
+			}
+		}
+		if p.strict {
+			return "", p.syntaxError(p.pos-1, fmt.Errorf("%w: \\u must be followed by four hex digits", InvalidEscapeError))
+		}
+	} else if p.strict && c != 'n' && c != 't' && c != '\\' && c != '"' {
+		return "", p.syntaxError(p.pos-1, fmt.Errorf("%w: \\%c", InvalidEscapeError, c))
+	}
 	p.pos++
 	var result strings.Builder
 	HandleEscapeSequence(&result, c)
@@ -76,10 +120,18 @@ func (p *LineParser) ParseQuotedString() (string, error) {
 			p.pos++
 			return result.String(), nil
 		}
+		if p.strict {
+			if r, _ := utf8.DecodeRuneInString(p.line[p.pos:]); IsControlCharacter(r) {
+				return "", p.syntaxError(p.pos, ControlCharacterError)
+			}
+		}
 		result.WriteByte(c)
 		p.pos++
 	}
 
+	if p.strict {
+		return "", p.syntaxError(len(p.line), UnterminatedStringError)
+	}
 	return result.String(), fmt.Errorf("unterminated quoted string")
 }
 
@@ -90,9 +142,16 @@ func (p *LineParser) ParseUnquotedToken() (string, error) {
 
 	for !p.IsEOF() {
 		c := p.line[p.pos]
-		if IsWhitespace(c) || IsArrayStart(c) || IsArrayEnd(c) {
+		if IsWhitespace(c) || IsArrayStart(c) || IsArrayEnd(c) || IsMapStart(c) || IsMapEnd(c) || p.IsComment() {
 			break
 		}
+		if p.strict {
+			if r, _ := utf8.DecodeRuneInString(p.line[p.pos:]); IsControlCharacter(r) {
+				return "", p.syntaxError(p.pos, ControlCharacterError)
+			} else if r >= utf8.RuneSelf {
+				return "", p.syntaxError(p.pos, NonAsciiCharacterError)
+			}
+		}
 		if IsEscape(c) {
 			result.WriteByte('\\')
 			if p.pos+1 < len(p.line) {
@@ -106,17 +165,22 @@ func (p *LineParser) ParseUnquotedToken() (string, error) {
 	}
 
 	if p.pos == start {
-		return "", fmt.Errorf("expected token at position %d", p.pos)
+		return "", p.syntaxError(p.pos, fmt.Errorf("expected token at position %d", p.pos))
 	}
 	return result.String(), nil
 }
 
-// ParseUnquotedTokenOrPropertyRef parses an unquoted token starting at the current position
+// ParseUnquotedTokenOrPropertyRef parses an unquoted token starting at the current position.
+// Tokens matching the numeric literal grammar are returned as int64 or float64.
 func (p *LineParser) ParseUnquotedTokenOrPropertyRef() (interface{}, error) {
+	start := p.pos
 	token, err := p.ParseUnquotedToken()
 	if err != nil {
 		return "", err
 	}
+	if p.strict {
+		return p.parseStrictToken(start, token)
+	}
 	if token == "*_" {
 		return NewSpreadPropertyRef("_"), nil
 	}
@@ -129,9 +193,54 @@ func (p *LineParser) ParseUnquotedTokenOrPropertyRef() (interface{}, error) {
 	if len(token) > 0 && IsDollar(token[0]) {
 		return NewPropertyRef(token[1:]), nil
 	}
+	if value, ok, err := ParseNumberLiteral(token); ok {
+		if err != nil {
+			return nil, fmt.Errorf("%w at position %d", err, p.pos-len(token))
+		}
+		return value, nil
+	}
 	return token, nil
 }
 
+// parseStrictToken interprets an unquoted token in strict mode
+func (p *LineParser) parseStrictToken(start int, token string) (interface{}, error) {
+	switch {
+	case token == "_":
+		return NewPropertyRef("_"), nil
+	case token == "*_":
+		return NewSpreadPropertyRef("_"), nil
+	case strings.HasPrefix(token, "$*"):
+		if !IsIdentifier(token[2:]) {
+			return nil, p.syntaxError(start, fmt.Errorf("%w: %s", InvalidIdentifierError, token))
+		}
+		return NewSpreadPropertyRef(strings.ToLower(token[2:])), nil
+	case IsDollar(token[0]):
+		if !IsIdentifier(token[1:]) {
+			return nil, p.syntaxError(start, fmt.Errorf("%w: %s", InvalidIdentifierError, token))
+		}
+		return NewPropertyRef(strings.ToLower(token[1:])), nil
+	}
+
+	value, ok, err := ParseNumberLiteral(token)
+	if err != nil {
+		return nil, p.syntaxError(start, err)
+	}
+	if ok {
+		return value, nil
+	}
+	if looksNumeric(token) {
+		return nil, p.syntaxError(start, fmt.Errorf("%w: %s", InvalidNumberLiteralError, token))
+	}
+	return token, nil
+}
+
+// looksNumeric returns true if a token starts like a numeric literal
+func looksNumeric(token string) bool {
+	rest := strings.TrimPrefix(token, "-")
+	rest = strings.TrimPrefix(rest, ".")
+	return len(rest) > 0 && rest[0] >= '0' && rest[0] <= '9'
+}
+
 // ParseArray parses an array starting at the current position
 func (p *LineParser) ParseArray() ([]interface{}, error) {
 	if !IsArrayStart(p.line[p.pos]) {
@@ -142,8 +251,8 @@ func (p *LineParser) ParseArray() ([]interface{}, error) {
 	var tokens []interface{}
 	for !p.IsEOF() {
 		p.ParseWhitespace()
-		if p.IsEOF() {
-			return nil, fmt.Errorf("unterminated array")
+		if p.IsEOF() || p.IsComment() {
+			return nil, p.syntaxError(p.pos, fmt.Errorf("unterminated array"))
 		}
 
 		token, err := p.ParseArrayElement()
@@ -169,11 +278,82 @@ func (p *LineParser) ParseArrayElement() (interface{}, error) {
 	case IsArrayEnd(p.line[p.pos]):
 		p.pos++
 		return nil, nil
+	case IsMapStart(p.line[p.pos]):
+		return p.ParseMap()
+	case IsMapEnd(p.line[p.pos]):
+		return nil, p.syntaxError(p.pos, fmt.Errorf("%w at position %d", UnexpectedMapEndError, p.pos))
 	default:
 		return p.ParseUnquotedTokenOrPropertyRef()
 	}
 }
 
+// ParseMap parses a map literal "{ key value ... }" starting at the current
+// position. Keys are quoted strings or bare tokens, which are taken as
+// strings even if they look like numbers; values are any array element.
+func (p *LineParser) ParseMap() (map[string]interface{}, error) {
+	if !IsMapStart(p.line[p.pos]) {
+		return nil, fmt.Errorf("expected map start at position %d", p.pos)
+	}
+	p.pos++ // Skip opening brace
+
+	result := make(map[string]interface{})
+	for {
+		p.ParseWhitespace()
+		if p.IsEOF() || p.IsComment() {
+			return nil, p.syntaxError(p.pos, UnterminatedMapError)
+		}
+		if IsMapEnd(p.line[p.pos]) {
+			p.pos++
+			return result, nil
+		}
+
+		start := p.pos
+		key, err := p.ParseMapKey()
+		if err != nil {
+			return nil, err
+		}
+		if _, exists := result[key]; exists {
+			return nil, p.syntaxError(start, fmt.Errorf("%w: %q", MapKeyDuplicateError, key))
+		}
+
+		p.ParseWhitespace()
+		if p.IsEOF() || p.IsComment() {
+			return nil, p.syntaxError(p.pos, UnterminatedMapError)
+		}
+		if IsMapEnd(p.line[p.pos]) {
+			return nil, p.syntaxError(p.pos, fmt.Errorf("%w: %q", MapValueMissingError, key))
+		}
+		value, err := p.ParseArrayElement()
+		if err != nil {
+			return nil, err
+		}
+		if value == nil {
+			return nil, p.syntaxError(p.pos-1, fmt.Errorf("unexpected array end character ']' at position %d", p.pos-1))
+		}
+		result[key] = value
+	}
+}
+
+// ParseMapKey parses the key of a map entry
+func (p *LineParser) ParseMapKey() (string, error) {
+	c := p.line[p.pos]
+	switch {
+	case IsQuote(c):
+		return p.ParseQuotedString()
+	case IsArrayStart(c) || IsArrayEnd(c) || IsMapStart(c) || IsDollar(c):
+		return "", p.syntaxError(p.pos, fmt.Errorf("%w at position %d", MapKeyInvalidError, p.pos))
+	}
+	start := p.pos
+	key, err := p.ParseUnquotedToken()
+	if err != nil {
+		return "", err
+	}
+	if key == "_" || key == "*_" {
+		return "", p.syntaxError(start, fmt.Errorf("%w at position %d", MapKeyInvalidError, start))
+	}
+	return key, nil
+}
+
 // ParseOpCode parses the operation code (first token) of the line
 func (p *LineParser) ParseOpCode() (interface{}, error) {
 	if p.IsEOF() {
@@ -186,8 +366,23 @@ func (p *LineParser) ParseOpCode() (interface{}, error) {
 		return nil, fmt.Errorf("operation code cannot be an array")
 	case IsArrayEnd(p.line[p.pos]):
 		return nil, fmt.Errorf("unexpected array end character ']' at position %d", p.pos)
+	case IsMapStart(p.line[p.pos]):
+		return nil, fmt.Errorf("operation code cannot be a map")
+	case IsMapEnd(p.line[p.pos]):
+		return nil, p.syntaxError(p.pos, fmt.Errorf("%w at position %d", UnexpectedMapEndError, p.pos))
 	default:
-		return p.ParseUnquotedToken()
+		if !p.strict {
+			return p.ParseUnquotedToken()
+		}
+		start := p.pos
+		token, err := p.ParseUnquotedToken()
+		if err != nil {
+			return nil, err
+		}
+		if !IsIdentifier(token) {
+			return nil, p.syntaxError(start, fmt.Errorf("%w: %s", InvalidOpcodeError, token))
+		}
+		return strings.ToLower(token), nil
 	}
 }
 
@@ -203,13 +398,37 @@ func (p *LineParser) ParseDestination() (interface{}, error) {
 		return nil, fmt.Errorf("destination cannot be an array")
 	case IsArrayEnd(p.line[p.pos]):
 		return nil, fmt.Errorf("unexpected array end character ']' at position %d", p.pos)
+	case IsMapStart(p.line[p.pos]):
+		return nil, fmt.Errorf("destination cannot be a map")
+	case p.strict && (IsDollar(p.line[p.pos]) || p.line[p.pos] == '_'):
+		start := p.pos
+		token, err := p.ParseUnquotedTokenOrPropertyRef()
+		if err != nil {
+			return nil, err
+		}
+		if ref, ok := GetPropertyRef(token); !ok || ref.Spread {
+			return nil, p.syntaxError(start, fmt.Errorf("%w: %s", InvalidDestinationError, p.line[start:p.pos]))
+		}
+		return token, nil
 	case IsDollar(p.line[p.pos]):
 		return p.ParseUnquotedTokenOrPropertyRef()
 	case p.line[p.pos] == '_':
 		return p.ParseUnquotedTokenOrPropertyRef()
 	default:
-		return nil, fmt.Errorf("destination is invalid at position %d", p.pos)
+		return nil, p.syntaxError(p.pos, fmt.Errorf("destination is invalid at position %d", p.pos))
+	}
+}
+
+// IsDestination returns true if the current token is a destination: a
+// $variable or the _ slot
+func (p *LineParser) IsDestination() bool {
+	if p.IsEOF() {
+		return false
 	}
+	if IsDollar(p.line[p.pos]) {
+		return true
+	}
+	return p.line[p.pos] == '_' && (p.pos+1 == len(p.line) || IsWhitespace(p.line[p.pos+1]) || (p.strict && IsHashtag(p.line[p.pos+1])))
 }
 
 // ParseRemainingTokens parses all remaining tokens in the line
@@ -218,7 +437,7 @@ func (p *LineParser) ParseRemainingTokens() ([]interface{}, error) {
 	for !p.IsEOF() {
 
 		p.ParseWhitespace()
-		if p.IsEOF() {
+		if p.IsEOF() || p.IsComment() {
 			break
 		}
 
@@ -239,7 +458,11 @@ func (p *LineParser) ParseRemainingToken() (interface{}, error) {
 	case IsArrayStart(p.line[p.pos]):
 		return p.ParseArray()
 	case IsArrayEnd(p.line[p.pos]):
-		return nil, fmt.Errorf("unexpected array end character ']' at position %d", p.pos)
+		return nil, p.syntaxError(p.pos, fmt.Errorf("unexpected array end character ']' at position %d", p.pos))
+	case IsMapStart(p.line[p.pos]):
+		return p.ParseMap()
+	case IsMapEnd(p.line[p.pos]):
+		return nil, p.syntaxError(p.pos, fmt.Errorf("%w at position %d", UnexpectedMapEndError, p.pos))
 	default:
 		return p.ParseUnquotedTokenOrPropertyRef()
 	}
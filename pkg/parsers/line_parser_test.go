@@ -317,3 +317,74 @@ func TestParseRemainingTokens(t *testing.T) {
 		})
 	}
 }
+
+func TestParseMap(t *testing.T) {
+	tests := []struct {
+		name        string
+		line        string
+		expected    map[string]interface{}
+		expectError bool
+	}{
+		{"empty map", "{}", map[string]interface{}{}, false},
+		{"simple map", "{ name ada age 36 }", map[string]interface{}{
+			"name": "ada",
+			"age":  int64(36),
+		}, false},
+		{"quoted key", `{ "first name" "Ada L" }`, map[string]interface{}{
+			"first name": "Ada L",
+		}, false},
+		{"numeric key", "{ 1 one }", map[string]interface{}{
+			"1": "one",
+		}, false},
+		{"nested", "{ user { id $id } tags [a b] }", map[string]interface{}{
+			"user": map[string]interface{}{"id": NewPropertyRef("id")},
+			"tags": []interface{}{"a", "b"},
+		}, false},
+		{"missing value", "{ a }", nil, true},
+		{"duplicate key", "{ a 1 a 2 }", nil, true},
+		{"variable key", "{ $a 1 }", nil, true},
+		{"array key", "{ [a] 1 }", nil, true},
+		{"unterminated", "{ a 1", nil, true},
+		{"array end", "{ a ] }", nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parser := NewLineParser(tt.line)
+			got, err := parser.ParseMap()
+			if (err != nil) != tt.expectError {
+				t.Errorf("ParseMap() error = %v, expectError %v", err, tt.expectError)
+				return
+			}
+			if !tt.expectError && !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("ParseMap() = %#v, want %#v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestParseMapRoundTrip(t *testing.T) {
+	values := []map[string]interface{}{
+		{},
+		{"name": "Ada Lovelace", "born": int64(1815), "ratio": 0.5},
+		{"odd key": []interface{}{"a b", int64(1)}, "nested": map[string]interface{}{"x": "{y}"}},
+		{"empty": "", "": "empty key"},
+		{"ref": "$x", "spread": "$*x", "input": "_", "spread input": "*_"},
+		{"int": "123", "float": "0.5", "hex": "0x1f", "negative": "-7", "123": int64(123)},
+		{"list": []interface{}{"a", "", "$b", "_", "42"}},
+	}
+
+	for _, value := range values {
+		str, err := ParseString(value)
+		if err != nil {
+			t.Fatalf("ParseString(%v) error = %v", value, err)
+		}
+		got, err := NewLineParser(str).ParseMap()
+		if err != nil {
+			t.Fatalf("ParseMap(%q) error = %v", str, err)
+		}
+		if !reflect.DeepEqual(got, value) {
+			t.Errorf("ParseMap(%q) = %#v, want %#v", str, got, value)
+		}
+	}
+}
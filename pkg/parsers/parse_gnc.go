@@ -0,0 +1,287 @@
+package parsers
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+var (
+	GncMissingHeaderError      = errors.New("gnc: missing header")
+	GncUnsupportedVersionError = errors.New("gnc: unsupported version")
+	GncInvalidTokenError       = errors.New("gnc: invalid token")
+	GncUnterminatedError       = errors.New("gnc: unterminated value")
+)
+
+// ParseGnc parses the compact .gnc text format into instructions
+func ParseGnc(source, content string) ([]*Instruction, error) {
+	var instructions []*Instruction
+	scanner := bufio.NewScanner(strings.NewReader(content))
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	lineNum := 0
+	headerFound := false
+
+	for scanner.Scan() {
+		lineNum++
+		line := strings.TrimSpace(scanner.Text())
+		if len(line) == 0 || IsHashtag(line[0]) {
+			continue
+		}
+
+		if !headerFound {
+			if err := parseGncHeader(line); err != nil {
+				return nil, fmt.Errorf("[%s]: error on line %d: %w", source, lineNum, err)
+			}
+			headerFound = true
+			continue
+		}
+
+		op, err := ParseGncInstruction(line)
+		if err != nil {
+			return nil, fmt.Errorf("[%s]: error on line %d: %w", source, lineNum, err)
+		}
+		instructions = append(instructions, op)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("[%s]: error reading file: %w", source, err)
+	}
+	if !headerFound {
+		return nil, fmt.Errorf("[%s]: %w", source, GncMissingHeaderError)
+	}
+	return instructions, nil
+}
+
+// parseGncHeader validates the "gnc <version>" header line
+func parseGncHeader(line string) error {
+	fields := strings.Fields(line)
+	if len(fields) != 2 || fields[0] != GncMagic {
+		return GncMissingHeaderError
+	}
+	version, err := strconv.Atoi(fields[1])
+	if err != nil || version != GncVersion {
+		return fmt.Errorf("%w: %s", GncUnsupportedVersionError, fields[1])
+	}
+	return nil
+}
+
+// ParseGncInstruction parses a single .gnc instruction line
+func ParseGncInstruction(line string) (*Instruction, error) {
+	p := &gncParser{line: line}
+
+	destToken := p.parseBareToken()
+	dest, ok := parseGncPropertyRef(destToken)
+	if !ok || dest.Spread {
+		return nil, fmt.Errorf("%w: destination %q", GncInvalidTokenError, destToken)
+	}
+
+	p.skipWhitespace()
+	opcode := p.parseBareToken()
+	if opcode == "" {
+		return nil, fmt.Errorf("%w: missing opcode", GncInvalidTokenError)
+	}
+
+	var args []interface{}
+	for {
+		p.skipWhitespace()
+		if p.isEOF() {
+			break
+		}
+		arg, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, arg)
+	}
+
+	return NewInstruction(opcode, dest, args), nil
+}
+
+// gncParser maintains the state for parsing a single .gnc line
+type gncParser struct {
+	line string
+	pos  int
+}
+
+func (p *gncParser) isEOF() bool {
+	return p.pos >= len(p.line)
+}
+
+func (p *gncParser) skipWhitespace() {
+	for !p.isEOF() && IsWhitespace(p.line[p.pos]) {
+		p.pos++
+	}
+}
+
+// parseBareToken reads characters up to the next whitespace or closing bracket
+func (p *gncParser) parseBareToken() string {
+	start := p.pos
+	for !p.isEOF() {
+		c := p.line[p.pos]
+		if IsWhitespace(c) || c == ']' || c == '}' {
+			break
+		}
+		p.pos++
+	}
+	return p.line[start:p.pos]
+}
+
+// parseValue parses a typed value starting at the current position
+func (p *gncParser) parseValue() (interface{}, error) {
+	switch p.line[p.pos] {
+	case '"':
+		quoted, err := strconv.QuotedPrefix(p.line[p.pos:])
+		if err != nil {
+			return nil, fmt.Errorf("%w: string at position %d", GncUnterminatedError, p.pos)
+		}
+		p.pos += len(quoted)
+		return strconv.Unquote(quoted)
+	case '[':
+		return p.parseArray()
+	case '{':
+		return p.parseMap()
+	default:
+		return parseGncScalar(p.parseBareToken())
+	}
+}
+
+// parseArray parses "[ value ... ]"
+func (p *gncParser) parseArray() ([]interface{}, error) {
+	p.pos++ // Skip opening bracket
+	result := []interface{}{}
+	for {
+		p.skipWhitespace()
+		if p.isEOF() {
+			return nil, fmt.Errorf("%w: array", GncUnterminatedError)
+		}
+		if p.line[p.pos] == ']' {
+			p.pos++
+			return result, nil
+		}
+		value, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, value)
+	}
+}
+
+// parseMap parses "{ "key" value ... }"
+func (p *gncParser) parseMap() (map[string]interface{}, error) {
+	p.pos++ // Skip opening brace
+	result := make(map[string]interface{})
+	for {
+		p.skipWhitespace()
+		if p.isEOF() {
+			return nil, fmt.Errorf("%w: map", GncUnterminatedError)
+		}
+		if p.line[p.pos] == '}' {
+			p.pos++
+			return result, nil
+		}
+		if p.line[p.pos] != '"' {
+			return nil, fmt.Errorf("%w: map key must be a quoted string at position %d", GncInvalidTokenError, p.pos)
+		}
+		key, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		p.skipWhitespace()
+		if p.isEOF() || p.line[p.pos] == '}' {
+			return nil, fmt.Errorf("%w: map key %q has no value", GncInvalidTokenError, key)
+		}
+		value, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		result[key.(string)] = value
+	}
+}
+
+// parseGncPropertyRef parses a property reference token
+func parseGncPropertyRef(token string) (*PropertyRef, bool) {
+	switch {
+	case token == "_":
+		return NewPropertyRef("_"), true
+	case token == "*_":
+		return NewSpreadPropertyRef("_"), true
+	case strings.HasPrefix(token, "$*") && len(token) > 2:
+		return NewSpreadPropertyRef(token[2:]), true
+	case strings.HasPrefix(token, "$") && len(token) > 1:
+		return NewPropertyRef(token[1:]), true
+	default:
+		return nil, false
+	}
+}
+
+// parseGncScalar parses a bare token: a property reference, nil, or "type:value"
+func parseGncScalar(token string) (interface{}, error) {
+	if ref, ok := parseGncPropertyRef(token); ok {
+		return ref, nil
+	}
+	if token == "nil" {
+		return nil, nil
+	}
+
+	kind, text, found := strings.Cut(token, ":")
+	if !found {
+		return nil, fmt.Errorf("%w: %q", GncInvalidTokenError, token)
+	}
+
+	var value interface{}
+	var err error
+	switch kind {
+	case "bool":
+		value, err = strconv.ParseBool(text)
+	case "int":
+		var v int64
+		v, err = strconv.ParseInt(text, 10, strconv.IntSize)
+		value = int(v)
+	case "int8":
+		var v int64
+		v, err = strconv.ParseInt(text, 10, 8)
+		value = int8(v)
+	case "int16":
+		var v int64
+		v, err = strconv.ParseInt(text, 10, 16)
+		value = int16(v)
+	case "int32":
+		var v int64
+		v, err = strconv.ParseInt(text, 10, 32)
+		value = int32(v)
+	case "int64":
+		value, err = strconv.ParseInt(text, 10, 64)
+	case "uint":
+		var v uint64
+		v, err = strconv.ParseUint(text, 10, strconv.IntSize)
+		value = uint(v)
+	case "uint8":
+		var v uint64
+		v, err = strconv.ParseUint(text, 10, 8)
+		value = uint8(v)
+	case "uint16":
+		var v uint64
+		v, err = strconv.ParseUint(text, 10, 16)
+		value = uint16(v)
+	case "uint32":
+		var v uint64
+		v, err = strconv.ParseUint(text, 10, 32)
+		value = uint32(v)
+	case "uint64":
+		value, err = strconv.ParseUint(text, 10, 64)
+	case "float32":
+		var v float64
+		v, err = strconv.ParseFloat(text, 32)
+		value = float32(v)
+	case "float64":
+		value, err = strconv.ParseFloat(text, 64)
+	default:
+		return nil, fmt.Errorf("%w: unknown type %q", GncInvalidTokenError, kind)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %q: %v", GncInvalidTokenError, token, err)
+	}
+	return value, nil
+}
@@ -0,0 +1,68 @@
+package parsers
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseGncRoundTrip(t *testing.T) {
+	instructions := []*Instruction{
+		NewInstruction("/gnd/let", NewPropertyRef("x"), []interface{}{"Hello \"World\"\n\t"}),
+		NewInstruction("/gnd/concat", NewPropertyRef("_"), []interface{}{NewPropertyRef("x"), NewSpreadPropertyRef("_")}),
+		NewInstruction("/gnd/let", NewPropertyRef("n"), []interface{}{
+			int(1), int8(-2), int16(3), int32(-4), int64(5),
+			uint(6), uint8(7), uint16(8), uint32(9), uint64(10),
+			float32(1.25), 2.5, false, nil,
+		}),
+		NewInstruction("/gnd/let", NewPropertyRef("a"), []interface{}{
+			[]interface{}{"a b", []interface{}{NewPropertyRef("x")}},
+			map[string]interface{}{"key with space": map[string]interface{}{}, "k": []interface{}{}},
+		}),
+		NewInstruction("/gnd/exit", NewPropertyRef("_"), nil),
+	}
+
+	content, err := FormatGnc(instructions)
+	assert.NoError(t, err)
+
+	parsed, err := ParseGnc("test.gnc", content)
+	assert.NoError(t, err)
+	assert.Equal(t, instructions, parsed)
+}
+
+func TestParseGnc(t *testing.T) {
+	parsed, err := ParseGnc("test.gnc", "# compiled\n\ngnc 1\n$x /gnd/let \"a\"\n")
+	assert.NoError(t, err)
+	assert.Equal(t, []*Instruction{NewInstruction("/gnd/let", NewPropertyRef("x"), []interface{}{"a"})}, parsed)
+}
+
+func TestParseGncErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		wantErr error
+	}{
+		{"empty", "", GncMissingHeaderError},
+		{"missing header", "$x /gnd/let \"a\"\n", GncMissingHeaderError},
+		{"unsupported version", "gnc 2\n", GncUnsupportedVersionError},
+		{"untyped literal", "gnc 1\n$x /gnd/let 5\n", GncInvalidTokenError},
+		{"unknown type", "gnc 1\n$x /gnd/let int128:5\n", GncInvalidTokenError},
+		{"out of range", "gnc 1\n$x /gnd/let int8:300\n", GncInvalidTokenError},
+		{"spread destination", "gnc 1\n$*x /gnd/let \"a\"\n", GncInvalidTokenError},
+		{"missing opcode", "gnc 1\n$x\n", GncInvalidTokenError},
+		{"unterminated string", "gnc 1\n$x /gnd/let \"a\n", GncUnterminatedError},
+		{"unterminated array", "gnc 1\n$x /gnd/let [ \"a\"\n", GncUnterminatedError},
+		{"unterminated map", "gnc 1\n$x /gnd/let { \"a\" \"b\"\n", GncUnterminatedError},
+		{"unquoted map key", "gnc 1\n$x /gnd/let { a \"b\" }\n", GncInvalidTokenError},
+		{"map key without value", "gnc 1\n$x /gnd/let { \"a\" }\n", GncInvalidTokenError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseGnc("test.gnc", tt.input)
+			assert.Error(t, err)
+			assert.True(t, errors.Is(err, tt.wantErr), "got %v", err)
+		})
+	}
+}
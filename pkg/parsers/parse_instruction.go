@@ -24,6 +24,26 @@ func ParseInstruction(source, line string) (*Instruction, error) {
 	if err != nil {
 		return nil, fmt.Errorf("failed to tokenize line: %w", err)
 	}
+	return newInstructionFromTokens(source, line, tokens)
+}
+
+// ParseInstructionStrict parses a single GND instruction line with the strict
+// lexer, see NewStrictLineParser. Blank and comment lines return nil. Errors
+// are *SyntaxError values without Source and Line, which the caller fills in.
+func ParseInstructionStrict(source, line string) (*Instruction, error) {
+	tokens, err := TokenizeLineStrict(line)
+	if errors.Is(err, EmptyLineError) {
+		loggers.Printf(loggers.Debug, "[%s]: Ignored line: %s", source, line)
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return newInstructionFromTokens(source, line, tokens)
+}
+
+// newInstructionFromTokens builds an instruction from the tokens of a line
+func newInstructionFromTokens(source, line string, tokens []interface{}) (*Instruction, error) {
 	if len(tokens) == 0 {
 		return nil, EmptyInstructionError
 	}
@@ -41,6 +61,9 @@ func ParseInstruction(source, line string) (*Instruction, error) {
 		}
 		tokens = tokens[1:]
 	} else {
+		if len(tokens) < 2 {
+			return nil, EmptyInstructionError
+		}
 		opcode, ok = tokens[1].(string)
 		if !ok {
 			return nil, OpcodeMustBeAStringError
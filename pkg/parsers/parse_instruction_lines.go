@@ -2,13 +2,23 @@ package parsers
 
 import (
 	"bufio"
+	"errors"
 	"fmt"
-	"github.com/hyperifyio/gnd/pkg/loggers"
 	"strings"
+	"unicode/utf8"
+
+	"github.com/hyperifyio/gnd/pkg/loggers"
 )
 
+// StrictMode makes ParseInstructionLines use the strict lexer of
+// ParseInstructionLinesStrict
+var StrictMode = false
+
 // ParseInstructionLines parses all instructions from a string
 func ParseInstructionLines(source, content string) ([]*Instruction, error) {
+	if StrictMode {
+		return ParseInstructionLinesStrict(source, content)
+	}
 	var instructions []*Instruction
 	scanner := bufio.NewScanner(strings.NewReader(content))
 	lineNum := 0
@@ -25,6 +35,9 @@ func ParseInstructionLines(source, content string) ([]*Instruction, error) {
 		}
 
 		if op != nil {
+			op.File = source
+			op.Line = lineNum
+			op.Column = instructionColumn(line)
 			instructions = append(instructions, op)
 		}
 	}
@@ -35,3 +48,57 @@ func ParseInstructionLines(source, content string) ([]*Instruction, error) {
 
 	return instructions, nil
 }
+
+// ParseInstructionLinesStrict parses all instructions from a string and
+// rejects anything the syntax specification does not allow: invalid UTF-8,
+// control characters, invalid identifiers, opcodes and escape sequences. A
+// leading byte order mark is ignored and CR-LF line endings are accepted.
+// Errors are returned as *SyntaxError with the exact line and column.
+func ParseInstructionLinesStrict(source, content string) ([]*Instruction, error) {
+	var instructions []*Instruction
+	content = strings.TrimPrefix(content, "\uFEFF")
+
+	for idx, line := range strings.Split(content, "\n") {
+		lineNum := idx + 1
+		line = strings.TrimSuffix(line, "\r")
+
+		if !utf8.ValidString(line) {
+			pos := 0
+			for pos < len(line) {
+				r, size := utf8.DecodeRuneInString(line[pos:])
+				if r == utf8.RuneError && size == 1 {
+					break
+				}
+				pos += size
+			}
+			err := NewSyntaxError(line, pos, InvalidUtf8Error)
+			err.Source, err.Line = source, lineNum
+			return nil, err
+		}
+
+		op, err := ParseInstructionStrict(fmt.Sprintf("%s:%d", source, lineNum), line)
+		if err != nil {
+			var syntaxErr *SyntaxError
+			if errors.As(err, &syntaxErr) {
+				syntaxErr.Source, syntaxErr.Line = source, lineNum
+				return nil, syntaxErr
+			}
+			return nil, &SyntaxError{Source: source, Line: lineNum, Column: 1, Err: err}
+		}
+
+		if op != nil {
+			op.File = source
+			op.Line = lineNum
+			op.Column = instructionColumn(line)
+			instructions = append(instructions, op)
+		}
+	}
+
+	return instructions, nil
+}
+
+// instructionColumn returns the 1-based column of the first token of a line
+func instructionColumn(line string) int {
+	indent := len(line) - len(strings.TrimLeft(line, " \t"))
+	return utf8.RuneCountInString(line[:indent]) + 1
+}
@@ -1,6 +1,8 @@
 package parsers
 
 import (
+	"errors"
+	"reflect"
 	"testing"
 )
 
@@ -134,3 +136,46 @@ func TestParseInstructionLines(t *testing.T) {
 		})
 	}
 }
+
+func TestParseInstructionLinesStrict(t *testing.T) {
+	content := "\uFEFF# comment\r\n$Msg LET \"caf\\u00e9\" 0x10 # trailing\r\n_ concat $MSG [1.5 $msg]\n"
+	instructions, err := ParseInstructionLinesStrict("test.gnd", content)
+	if err != nil {
+		t.Fatalf("ParseInstructionLinesStrict() error = %v", err)
+	}
+	want := []*Instruction{
+		{
+			Opcode:      "let",
+			Destination: NewPropertyRef("msg"),
+			Arguments:   []interface{}{"café", int64(16)},
+			File:        "test.gnd",
+			Line:        2,
+			Column:      1,
+		},
+		{
+			Opcode:      "concat",
+			Destination: NewPropertyRef("_"),
+			Arguments:   []interface{}{NewPropertyRef("msg"), []interface{}{1.5, NewPropertyRef("msg")}},
+			File:        "test.gnd",
+			Line:        3,
+			Column:      1,
+		},
+	}
+	if !reflect.DeepEqual(instructions, want) {
+		t.Errorf("ParseInstructionLinesStrict() = %+v, want %+v", instructions, want)
+	}
+}
+
+func TestParseInstructionLinesStrictMode(t *testing.T) {
+	StrictMode = true
+	defer func() { StrictMode = false }()
+
+	_, err := ParseInstructionLines("test.gnd", "$x let \"a\\qb\"")
+	var syntaxErr *SyntaxError
+	if !errors.As(err, &syntaxErr) {
+		t.Fatalf("ParseInstructionLines() error = %v, want *SyntaxError", err)
+	}
+	if syntaxErr.Line != 1 || syntaxErr.Column != 10 {
+		t.Errorf("ParseInstructionLines() error at %d:%d, want 1:10", syntaxErr.Line, syntaxErr.Column)
+	}
+}
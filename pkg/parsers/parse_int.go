@@ -10,6 +10,11 @@ func ParseInt(arg interface{}) (int, error) {
 	switch v := arg.(type) {
 	case int:
 		return v, nil
+	case int64:
+		if int64(int(v)) != v {
+			return 0, fmt.Errorf("ParseInt: value out of range: %v", v)
+		}
+		return int(v), nil
 	case string:
 		code, err := strconv.Atoi(v)
 		if err != nil {
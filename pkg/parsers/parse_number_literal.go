@@ -0,0 +1,53 @@
+package parsers
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var NumberLiteralOverflowError = errors.New("numeric literal out of range")
+
+var (
+	decimalLiteralPattern = regexp.MustCompile(`^-?[0-9]+$`)
+	hexLiteralPattern     = regexp.MustCompile(`^-?0x[0-9A-Fa-f]+$`)
+	floatLiteralPattern   = regexp.MustCompile(`^-?(?:[0-9]+\.[0-9]*|\.[0-9]+)(?:[eE][+-]?[0-9]+)?$`)
+)
+
+// ParseNumberLiteral converts a bare token matching the decimal, hexadecimal
+// or float literal grammar into an int64 or float64. The second return value
+// is false if the token is not a numeric literal. Literals that do not fit
+// into the result type are reported as NumberLiteralOverflowError.
+func ParseNumberLiteral(token string) (interface{}, bool, error) {
+	switch {
+	case decimalLiteralPattern.MatchString(token):
+		value, err := strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return nil, true, fmt.Errorf("%w: %s", NumberLiteralOverflowError, token)
+		}
+		return value, true, nil
+
+	case hexLiteralPattern.MatchString(token):
+		digits, negative := strings.CutPrefix(token, "-")
+		magnitude, err := strconv.ParseUint(digits[2:], 16, 64)
+		if err != nil || (!negative && magnitude > 1<<63-1) || (negative && magnitude > 1<<63) {
+			return nil, true, fmt.Errorf("%w: %s", NumberLiteralOverflowError, token)
+		}
+		if negative {
+			return -int64(magnitude), true, nil
+		}
+		return int64(magnitude), true, nil
+
+	case floatLiteralPattern.MatchString(token):
+		value, err := strconv.ParseFloat(token, 64)
+		if err != nil {
+			return nil, true, fmt.Errorf("%w: %s", NumberLiteralOverflowError, token)
+		}
+		return value, true, nil
+
+	default:
+		return nil, false, nil
+	}
+}
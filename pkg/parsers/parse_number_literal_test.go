@@ -0,0 +1,53 @@
+package parsers
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseNumberLiteral(t *testing.T) {
+	tests := []struct {
+		token     string
+		want      interface{}
+		isLiteral bool
+		overflow  bool
+	}{
+		{token: "0", want: int64(0), isLiteral: true},
+		{token: "1000", want: int64(1000), isLiteral: true},
+		{token: "-42", want: int64(-42), isLiteral: true},
+		{token: "0x1F", want: int64(31), isLiteral: true},
+		{token: "-0xff", want: int64(-255), isLiteral: true},
+		{token: "9223372036854775807", want: int64(9223372036854775807), isLiteral: true},
+		{token: "-9223372036854775808", want: int64(-9223372036854775808), isLiteral: true},
+		{token: "-0x8000000000000000", want: int64(-9223372036854775808), isLiteral: true},
+		{token: "1.5", want: 1.5, isLiteral: true},
+		{token: "1.", want: 1.0, isLiteral: true},
+		{token: ".5", want: 0.5, isLiteral: true},
+		{token: "-2.5e3", want: -2500.0, isLiteral: true},
+		{token: "9223372036854775808", isLiteral: true, overflow: true},
+		{token: "0x8000000000000000", isLiteral: true, overflow: true},
+		{token: "1e999", isLiteral: false},
+		{token: "1.0e999", isLiteral: true, overflow: true},
+		{token: "abc", isLiteral: false},
+		{token: ".", isLiteral: false},
+		{token: "-", isLiteral: false},
+		{token: "1a", isLiteral: false},
+		{token: "0X1F", isLiteral: false},
+		{token: "+1", isLiteral: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.token, func(t *testing.T) {
+			got, isLiteral, err := ParseNumberLiteral(tt.token)
+			assert.Equal(t, tt.isLiteral, isLiteral)
+			if tt.overflow {
+				assert.True(t, errors.Is(err, NumberLiteralOverflowError))
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -29,6 +29,40 @@ func escapeString(s string) string {
 	return string(b)
 }
 
+// needsLiteralEscaping returns true if the string must be quoted inside an
+// array or map literal to be parsed back as the same string: it contains
+// characters that need escaping or brackets, is empty, or would be read as a
+// variable reference or a number
+func needsLiteralEscaping(s string) bool {
+	if s == "" || s == "_" || s == "*_" || strings.HasPrefix(s, "$") {
+		return true
+	}
+	if _, ok, _ := ParseNumberLiteral(s); ok {
+		return true
+	}
+	return strings.ContainsAny(s, " \t\n\r\"'\\[]{}")
+}
+
+// parseElement is ParseString for a key or an element of a map or array.
+// Strings are quoted when needed so that the literal parses back to the same
+// value.
+func parseElement(arg interface{}) (string, error) {
+	var s string
+	switch v := arg.(type) {
+	case string:
+		s = v
+	case error:
+		s = v.Error()
+	default:
+		return ParseString(arg)
+	}
+	if !needsLiteralEscaping(s) {
+		return s, nil
+	}
+	b, _ := json.Marshal(s)
+	return string(b), nil
+}
+
 func ParseString(arg interface{}) (string, error) {
 	switch v := arg.(type) {
 	case nil:
@@ -62,11 +96,12 @@ func ParseString(arg interface{}) (string, error) {
 
 		var pairs []string
 		for _, key := range keys {
-			valStr, err := ParseString(v[key])
+			valStr, err := parseElement(v[key])
 			if err != nil {
 				return "", ErrFailedToMarshalMap
 			}
-			pairs = append(pairs, key, valStr)
+			keyStr, _ := parseElement(key)
+			pairs = append(pairs, keyStr, valStr)
 		}
 		return "{ " + strings.Join(pairs, " ") + " }", nil
 
@@ -76,7 +111,7 @@ func ParseString(arg interface{}) (string, error) {
 		}
 		var strArgs []string
 		for _, item := range v {
-			str, err := ParseString(item)
+			str, err := parseElement(item)
 			if err != nil {
 				return "", ErrInvalidArrayElement
 			}
@@ -84,13 +119,17 @@ func ParseString(arg interface{}) (string, error) {
 		}
 		return "[ " + strings.Join(strArgs, " ") + " ]", nil
 
+	case error:
+		// Error values of a program, e.g. caught by try, print as their message
+		return escapeString(v.Error()), nil
+
 	case []string:
 		if len(v) == 0 {
 			return "[]", nil
 		}
 		var strArgs []string
 		for _, item := range v {
-			str, err := ParseString(item)
+			str, err := parseElement(item)
 			if err != nil {
 				return "", ErrInvalidArrayElement
 			}
@@ -118,7 +157,7 @@ func ParseString(arg interface{}) (string, error) {
 			}
 			var strArgs []string
 			for i := 0; i < rv.Len(); i++ {
-				str, err := ParseString(rv.Index(i).Interface())
+				str, err := parseElement(rv.Index(i).Interface())
 				if err != nil {
 					return "", ErrInvalidArrayElement
 				}
@@ -135,7 +174,7 @@ func ParseString(arg interface{}) (string, error) {
 			iter := rv.MapRange()
 			for iter.Next() {
 				key := iter.Key()
-				keyStr, err := ParseString(key.Interface())
+				keyStr, err := parseElement(key.Interface())
 				if err != nil {
 					return "", ErrFailedToMarshalMap
 				}
@@ -149,9 +188,9 @@ func ParseString(arg interface{}) (string, error) {
 				iter := rv.MapRange()
 				for iter.Next() {
 					key := iter.Key()
-					keyStr2, _ := ParseString(key.Interface())
+					keyStr2, _ := parseElement(key.Interface())
 					if keyStr2 == keyStr {
-						valStr, err := ParseString(iter.Value().Interface())
+						valStr, err := parseElement(iter.Value().Interface())
 						if err != nil {
 							return "", ErrFailedToMarshalMap
 						}
@@ -1,6 +1,9 @@
 package parsers
 
-import "testing"
+import (
+	"errors"
+	"testing"
+)
 
 func TestParseString(t *testing.T) {
 	tests := []struct {
@@ -21,6 +24,24 @@ func TestParseString(t *testing.T) {
 			want:    "hello",
 			wantErr: false,
 		},
+		{
+			name:    "numeric string is not quoted",
+			input:   "42",
+			want:    "42",
+			wantErr: false,
+		},
+		{
+			name:    "string with brackets is not quoted",
+			input:   "[ok]",
+			want:    "[ok]",
+			wantErr: false,
+		},
+		{
+			name:    "empty string is not quoted",
+			input:   "",
+			want:    "",
+			wantErr: false,
+		},
 		{
 			name:    "int",
 			input:   123,
@@ -57,12 +78,30 @@ func TestParseString(t *testing.T) {
 			want:    `{ key "hello world" }`,
 			wantErr: false,
 		},
+		{
+			name:    "map with escapable key",
+			input:   map[string]interface{}{"first name": "a[0]"},
+			want:    `{ "first name" "a[0]" }`,
+			wantErr: false,
+		},
 		{
 			name:    "array of strings",
 			input:   []interface{}{"a", "b"},
 			want:    "[ a b ]",
 			wantErr: false,
 		},
+		{
+			name:    "array with empty string",
+			input:   []interface{}{"a", "b", "", "c"},
+			want:    `[ a b "" c ]`,
+			wantErr: false,
+		},
+		{
+			name:    "strings read as references or numbers",
+			input:   []interface{}{"$x", "_", "*_", "123", "1.5"},
+			want:    `[ "$x" "_" "*_" "123" "1.5" ]`,
+			wantErr: false,
+		},
 		{
 			name:    "nested array",
 			input:   []interface{}{"hello", []interface{}{"world", 123}},
@@ -75,6 +114,12 @@ func TestParseString(t *testing.T) {
 			want:    "[ hi [ \"hello world\" 123 ] ]",
 			wantErr: false,
 		},
+		{
+			name:    "error is its message",
+			input:   []interface{}{errors.New("not found")},
+			want:    "[ \"not found\" ]",
+			wantErr: false,
+		},
 		{
 			name:    "invalid type",
 			input:   struct{}{},
@@ -0,0 +1,49 @@
+package parsers
+
+import (
+	"errors"
+	"fmt"
+	"unicode/utf8"
+)
+
+var (
+	InvalidUtf8Error          = errors.New("invalid UTF-8 encoding")
+	ControlCharacterError     = errors.New("control character not allowed")
+	NonAsciiCharacterError    = errors.New("non-ASCII character outside string literal")
+	InvalidEscapeError        = errors.New("invalid escape sequence")
+	InvalidIdentifierError    = errors.New("invalid identifier")
+	InvalidOpcodeError        = errors.New("invalid opcode")
+	InvalidDestinationError   = errors.New("invalid destination")
+	InvalidNumberLiteralError = errors.New("invalid numeric literal")
+	MissingOpcodeError        = errors.New("missing opcode")
+	UnterminatedStringError   = errors.New("unterminated quoted string")
+)
+
+// SyntaxError reports a lexical or grammatical error found by the strict
+// parser. Line and Column are 1-based; Column counts characters, not bytes.
+type SyntaxError struct {
+	Source string
+	Line   int
+	Column int
+	Err    error
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("%s:%d:%d: %v", e.Source, e.Line, e.Column, e.Err)
+}
+
+func (e *SyntaxError) Unwrap() error {
+	return e.Err
+}
+
+// NewSyntaxError creates a SyntaxError for the byte offset pos in line.
+// Source and Line are filled in by the caller that knows them.
+func NewSyntaxError(line string, pos int, err error) *SyntaxError {
+	if pos > len(line) {
+		pos = len(line)
+	}
+	return &SyntaxError{
+		Column: utf8.RuneCountInString(line[:pos]) + 1,
+		Err:    err,
+	}
+}
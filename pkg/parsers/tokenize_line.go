@@ -9,16 +9,34 @@ var EmptyLineError = errors.New("empty line")
 // TokenizeLine tokenizes a line at the top level, ensuring the first two tokens are plain strings,
 // and subsequent tokens are wrapped as PropertyRef if unquoted.
 func TokenizeLine(line string) ([]interface{}, error) {
-	p := NewLineParser(line)
+	return tokenizeLine(NewLineParser(line))
+}
+
+// TokenizeLineStrict tokenizes a line like TokenizeLine, but enforces the
+// lexical rules of the syntax specification. Errors are *SyntaxError values.
+// A line holding only a comment returns EmptyLineError.
+func TokenizeLineStrict(line string) ([]interface{}, error) {
+	p := NewStrictLineParser(line)
+	tokens, err := tokenizeLine(p)
+	if err != nil && !errors.Is(err, EmptyLineError) {
+		var syntaxErr *SyntaxError
+		if !errors.As(err, &syntaxErr) {
+			return nil, NewSyntaxError(line, p.pos, err)
+		}
+	}
+	return tokens, err
+}
+
+func tokenizeLine(p *LineParser) ([]interface{}, error) {
 	var tokens []interface{}
 
 	// Parse optional destination
 	p.ParseWhitespace()
-	if p.IsEOF() {
+	if p.IsEOF() || p.IsComment() {
 		return nil, EmptyLineError
 	}
 
-	if p.IsDollar() {
+	if p.IsDestination() {
 		token, err := p.ParseDestination()
 		if err != nil {
 			return nil, err
@@ -28,11 +46,17 @@ func TokenizeLine(line string) ([]interface{}, error) {
 
 	// Parse operation code
 	p.ParseWhitespace()
-	if p.IsEOF() {
+	if p.IsEOF() || p.IsComment() {
+		if p.strict {
+			return nil, p.syntaxError(p.pos, MissingOpcodeError)
+		}
 		return nil, EmptyLineError
 	}
 	token, err := p.ParseOpCode()
 	if err != nil {
+		if p.strict {
+			return nil, err
+		}
 		return tokens, nil
 	}
 	tokens = append(tokens, token)
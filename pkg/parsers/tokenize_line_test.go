@@ -102,6 +102,24 @@ func TestTokenizeLine(t *testing.T) {
 			expected: []interface{}{"op", "quoted", "arg1"},
 			wantErr:  false,
 		},
+		{
+			name:     "numeric literals",
+			line:     "op 1000 -0x10 2.5 [1 .5] \"42\" 1a",
+			expected: []interface{}{"op", int64(1000), int64(-16), 2.5, []interface{}{int64(1), 0.5}, "42", "1a"},
+			wantErr:  false,
+		},
+		{
+			name:     "explicit underscore destination",
+			line:     "_ op \"\\u00e9\"",
+			expected: []interface{}{NewPropertyRef("_"), "op", "é"},
+			wantErr:  false,
+		},
+		{
+			name:     "numeric literal overflow",
+			line:     "op 99999999999999999999",
+			expected: nil,
+			wantErr:  true,
+		},
 	}
 
 	for _, tt := range tests {
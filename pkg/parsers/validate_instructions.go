@@ -0,0 +1,95 @@
+package parsers
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
+
+var (
+	ValidationRebindError    = errors.New("identifier is already bound")
+	ValidationUndefinedError = errors.New("identifier is not bound yet")
+)
+
+// ValidationError reports a single-assignment violation of an instruction
+type ValidationError struct {
+	Position string // "file:line" of the offending instruction, if known
+	Name     string // The identifier without the $ prefix
+	Err      error  // ValidationRebindError or ValidationUndefinedError
+	Previous string // Position of the earlier binding for rebind errors
+}
+
+func (e *ValidationError) Error() string {
+	msg := fmt.Sprintf("%v: $%s", e.Err, e.Name)
+	if e.Previous != "" {
+		msg += " (first bound at " + e.Previous + ")"
+	}
+	if e.Position != "" {
+		return e.Position + ": " + msg
+	}
+	return msg
+}
+
+func (e *ValidationError) Unwrap() error {
+	return e.Err
+}
+
+// ValidateInstructions checks the single-assignment rule of the syntax
+// specification over a whole unit: a destination other than _ must not be
+// bound twice, and arguments may only reference identifiers bound by an
+// earlier instruction. Fragments must be validated as one concatenated list.
+// All violations are reported, joined into a single error.
+func ValidateInstructions(instructions []*Instruction) error {
+	bound := make(map[string]string)
+	var errs []error
+
+	for _, op := range instructions {
+		if op == nil {
+			continue
+		}
+
+		for _, name := range referencedNames(op.Arguments, nil) {
+			if name == "_" {
+				continue
+			}
+			if _, ok := bound[name]; !ok {
+				errs = append(errs, &ValidationError{Position: op.Position(), Name: name, Err: ValidationUndefinedError})
+			}
+		}
+
+		if op.Destination == nil || op.Destination.Name == "_" {
+			continue
+		}
+		name := op.Destination.Name
+		if previous, ok := bound[name]; ok {
+			errs = append(errs, &ValidationError{Position: op.Position(), Name: name, Err: ValidationRebindError, Previous: previous})
+			continue
+		}
+		bound[name] = op.Position()
+	}
+
+	return errors.Join(errs...)
+}
+
+// referencedNames appends the names of all property references in args,
+// including those nested in arrays and maps
+func referencedNames(args []interface{}, names []string) []string {
+	for _, arg := range args {
+		switch v := arg.(type) {
+		case *PropertyRef:
+			names = append(names, v.Name)
+		case []interface{}:
+			names = referencedNames(v, names)
+		case map[string]interface{}:
+			keys := make([]string, 0, len(v))
+			for key := range v {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+			for _, key := range keys {
+				names = referencedNames([]interface{}{v[key]}, names)
+			}
+		}
+	}
+	return names
+}
@@ -0,0 +1,76 @@
+package parsers
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateInstructions(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		wantErr []string
+	}{
+		{
+			name:  "valid unit",
+			input: "$a let 1\n$b concat $a _\n_ concat $a $b\nconcat $*b\n$c let [ $a [ $b ] ]",
+		},
+		{
+			name:    "rebinding",
+			input:   "$a let 1\n$b let 2\n$a let 3",
+			wantErr: []string{"test.gnd:3: identifier is already bound: $a (first bound at test.gnd:1)"},
+		},
+		{
+			name:    "forward reference",
+			input:   "$a concat $b\n$b let 1",
+			wantErr: []string{"test.gnd:1: identifier is not bound yet: $b"},
+		},
+		{
+			name:    "self reference",
+			input:   "$a concat $a",
+			wantErr: []string{"test.gnd:1: identifier is not bound yet: $a"},
+		},
+		{
+			name:    "nested and spread references",
+			input:   "$a let [ $x ]\nconcat $*y",
+			wantErr: []string{"test.gnd:1: identifier is not bound yet: $x", "test.gnd:2: identifier is not bound yet: $y"},
+		},
+		{
+			name:  "underscore may be reassigned",
+			input: "_ let 1\n_ let 2\nlet 3",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			instructions, err := ParseInstructionLines("test.gnd", tt.input)
+			assert.NoError(t, err)
+
+			err = ValidateInstructions(instructions)
+			if len(tt.wantErr) == 0 {
+				assert.NoError(t, err)
+				return
+			}
+			assert.Error(t, err)
+			for _, msg := range tt.wantErr {
+				assert.Contains(t, err.Error(), msg)
+			}
+		})
+	}
+}
+
+func TestValidateInstructionsAcrossFragments(t *testing.T) {
+	first, err := ParseInstructionLines("010-sum.gnd", "$a let 1")
+	assert.NoError(t, err)
+	second, err := ParseInstructionLines("sum.gnd", "$a let 2")
+	assert.NoError(t, err)
+
+	err = ValidateInstructions(append(first, second...))
+	var validationErr *ValidationError
+	assert.True(t, errors.As(err, &validationErr))
+	assert.Equal(t, "sum.gnd:1", validationErr.Position)
+	assert.Equal(t, "010-sum.gnd:1", validationErr.Previous)
+	assert.True(t, errors.Is(err, ValidationRebindError))
+}
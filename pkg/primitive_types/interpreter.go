@@ -1,6 +1,9 @@
 package primitive_types
 
 import (
+	"context"
+	"regexp"
+
 	"github.com/hyperifyio/gnd/pkg/parsers"
 )
 
@@ -10,6 +13,10 @@ type Interpreter interface {
 	// GetScriptDir returns the script directory
 	GetScriptDir() string
 
+	// GetContext returns the context of the routine. It is done when the task
+	// running the routine is cancelled.
+	GetContext() context.Context
+
 	// GetLogIndent returns the current log indentation level
 	GetLogIndent() int
 
@@ -44,6 +51,14 @@ type Interpreter interface {
 	// ResolveOpcode
 	ResolveOpcode(opcode string) string
 
+	// CompileRegexp compiles an RE2 pattern. Compiled patterns are cached and
+	// shared with child interpreters.
+	CompileRegexp(pattern string) (*regexp.Regexp, error)
+
 	// NewInterpreterWithParent
 	NewInterpreterWithParent(scriptDir string, initialSlots map[string]interface{}) Interpreter
+
+	// NewInterpreterWithContext is like NewInterpreterWithParent, but the
+	// child runs with ctx instead of the context of the parent
+	NewInterpreterWithContext(ctx context.Context, scriptDir string, initialSlots map[string]interface{}) Interpreter
 }
@@ -1,5 +1,7 @@
 package primitive_types
 
+import "context"
+
 // Primitive represents a GND primitive function
 type Primitive interface {
 	// Name returns the name of the primitive (e.g. "/gnd/concat")
@@ -7,3 +9,12 @@ type Primitive interface {
 	// Execute runs the primitive with the given arguments
 	Execute(args []interface{}) (interface{}, error)
 }
+
+// ContextPrimitive is a primitive that can be interrupted, e.g. because the
+// task that runs it was cancelled. The interpreter calls ExecuteWithContext
+// instead of Execute.
+type ContextPrimitive interface {
+	Primitive
+	// ExecuteWithContext runs the primitive and returns early when ctx is done
+	ExecuteWithContext(ctx context.Context, args []interface{}) (interface{}, error)
+}
@@ -0,0 +1,39 @@
+package primitives
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/hyperifyio/gnd/pkg/primitive_services"
+	"github.com/hyperifyio/gnd/pkg/primitive_types"
+)
+
+var AddRequiresArgumentsError = errors.New("add: requires at least two arguments")
+
+// Add represents the add primitive
+type Add struct{}
+
+var _ primitive_types.Primitive = &Add{}
+
+func (p *Add) Name() string {
+	return "/gnd/add"
+}
+
+// Execute returns the sum of the arguments
+func (p *Add) Execute(args []interface{}) (interface{}, error) {
+	if len(args) < 2 {
+		return nil, AddRequiresArgumentsError
+	}
+	return foldArithmetic("add", args, arithmeticOp{
+		ints: func(a, b *big.Int) (*big.Int, error) {
+			return new(big.Int).Add(a, b), nil
+		},
+		floats: func(a, b float64) (float64, error) {
+			return a + b, nil
+		},
+	})
+}
+
+func init() {
+	primitive_services.RegisterPrimitive(&Add{})
+}
@@ -0,0 +1,43 @@
+package primitives
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdd(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []interface{}
+		want    interface{}
+		wantErr error
+	}{
+		{"int64", []interface{}{int64(1), int64(2), int64(3)}, int64(6), nil},
+		{"same type kept", []interface{}{int8(100), int8(27)}, int8(127), nil},
+		{"same type overflow", []interface{}{int8(100), int8(28)}, nil, ArithmeticOverflowError},
+		{"mixed integers", []interface{}{int8(100), int16(100)}, int64(200), nil},
+		{"unsigned", []interface{}{uint8(1), uint16(2)}, uint64(3), nil},
+		{"float promotion", []interface{}{int64(1), 0.5}, 1.5, nil},
+		{"float32", []interface{}{float32(1.5), float32(1)}, float32(2.5), nil},
+		{"numeric strings", []interface{}{"2", " 0x10 "}, int64(18), nil},
+		{"int64 overflow", []interface{}{int64(math.MaxInt64), int64(1)}, nil, ArithmeticOverflowError},
+		{"float overflow", []interface{}{math.MaxFloat64, math.MaxFloat64}, nil, ArithmeticOverflowError},
+		{"invalid string", []interface{}{int64(1), "one"}, nil, ArithmeticInvalidArgumentError},
+		{"invalid type", []interface{}{int64(1), true}, nil, ArithmeticInvalidArgumentError},
+		{"one argument", []interface{}{int64(1)}, nil, AddRequiresArgumentsError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := (&Add{}).Execute(tt.args)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
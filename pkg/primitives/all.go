@@ -0,0 +1,50 @@
+package primitives
+
+import (
+	"errors"
+
+	"github.com/hyperifyio/gnd/pkg/primitive_services"
+	"github.com/hyperifyio/gnd/pkg/primitive_types"
+)
+
+var AllRequiresListError = errors.New("all: requires exactly one array argument")
+
+// All represents the all primitive
+type All struct{}
+
+var _ primitive_types.Primitive = &All{}
+
+func (p *All) Name() string {
+	return "/gnd/all"
+}
+
+// Execute returns true if every element of the array is true, using the
+// truthiness rules of bool. An empty array is true.
+func (p *All) Execute(args []interface{}) (interface{}, error) {
+	list, err := parseLogicList(args, AllRequiresListError)
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range list {
+		if !Truthy(item) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// parseLogicList returns the array argument of all and any
+func parseLogicList(args []interface{}, requiresErr error) ([]interface{}, error) {
+	if len(args) != 1 {
+		return nil, requiresErr
+	}
+	list, ok := args[0].([]interface{})
+	if !ok {
+		return nil, requiresErr
+	}
+	return list, nil
+}
+
+func init() {
+	primitive_services.RegisterPrimitive(&All{})
+}
@@ -0,0 +1,26 @@
+package primitives
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAll(t *testing.T) {
+	got, err := (&All{}).Execute([]interface{}{[]interface{}{true, int64(1), "x"}})
+	assert.NoError(t, err)
+	assert.Equal(t, true, got)
+
+	got, err = (&All{}).Execute([]interface{}{[]interface{}{true, int64(0)}})
+	assert.NoError(t, err)
+	assert.Equal(t, false, got)
+
+	got, err = (&All{}).Execute([]interface{}{[]interface{}{}})
+	assert.NoError(t, err)
+	assert.Equal(t, true, got)
+
+	_, err = (&All{}).Execute([]interface{}{true, true})
+	assert.ErrorIs(t, err, AllRequiresListError)
+	_, err = (&All{}).Execute([]interface{}{"list"})
+	assert.ErrorIs(t, err, AllRequiresListError)
+}
@@ -0,0 +1,37 @@
+package primitives
+
+import (
+	"errors"
+
+	"github.com/hyperifyio/gnd/pkg/parsers"
+	"github.com/hyperifyio/gnd/pkg/primitive_services"
+	"github.com/hyperifyio/gnd/pkg/primitive_types"
+)
+
+var AndRequiresArgumentsError = errors.New("and: requires at least two arguments")
+
+// And represents the and primitive
+type And struct{}
+
+var _ primitive_types.Primitive = &And{}
+var _ primitive_types.BlockSuccessResultHandler = &And{}
+
+func (p *And) Name() string {
+	return "/gnd/and"
+}
+
+// Execute returns true if every argument is true, using the truthiness rules of bool.
+// Routine arguments are run by HandleBlockSuccessResult.
+func (p *And) Execute(args []interface{}) (interface{}, error) {
+	return executeLogic(args, false, AndRequiresArgumentsError)
+}
+
+// HandleBlockSuccessResult runs routine arguments in order until the result
+// is known
+func (p *And) HandleBlockSuccessResult(result interface{}, i primitive_types.Interpreter, destination *parsers.PropertyRef, _ []*parsers.Instruction) (interface{}, error) {
+	return handleLogicResult(p.Name(), result, i, destination)
+}
+
+func init() {
+	primitive_services.RegisterPrimitive(&And{})
+}
@@ -0,0 +1,44 @@
+package primitives_test
+
+import (
+	"testing"
+
+	"github.com/hyperifyio/gnd/pkg/primitives"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAnd(t *testing.T) {
+	and := &primitives.And{}
+
+	got, err := and.Execute([]interface{}{true, int64(1), "yes"})
+	assert.NoError(t, err)
+	assert.Equal(t, true, got)
+
+	got, err = and.Execute([]interface{}{true, ""})
+	assert.NoError(t, err)
+	assert.Equal(t, false, got)
+
+	_, err = and.Execute([]interface{}{true})
+	assert.ErrorIs(t, err, primitives.AndRequiresArgumentsError)
+
+	slots := map[string]interface{}{
+		"yes":  parseRoutine(t, "bool 1\n"),
+		"no":   parseRoutine(t, "bool 0\n"),
+		"boom": parseRoutine(t, "throw \"evaluated\"\n"),
+	}
+
+	got, err = runBlock(t, slots, `$result and 1 $yes`)
+	assert.NoError(t, err)
+	assert.Equal(t, true, got)
+
+	got, err = runBlock(t, slots, `$result and $no $boom`)
+	assert.NoError(t, err)
+	assert.Equal(t, false, got)
+
+	got, err = runBlock(t, slots, `$result and 0 $boom`)
+	assert.NoError(t, err)
+	assert.Equal(t, false, got)
+
+	_, err = runBlock(t, slots, `$result and $yes $boom`)
+	assert.ErrorContains(t, err, "evaluated")
+}
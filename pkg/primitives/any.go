@@ -0,0 +1,38 @@
+package primitives
+
+import (
+	"errors"
+
+	"github.com/hyperifyio/gnd/pkg/primitive_services"
+	"github.com/hyperifyio/gnd/pkg/primitive_types"
+)
+
+var AnyRequiresListError = errors.New("any: requires exactly one array argument")
+
+// Any represents the any primitive
+type Any struct{}
+
+var _ primitive_types.Primitive = &Any{}
+
+func (p *Any) Name() string {
+	return "/gnd/any"
+}
+
+// Execute returns true if any element of the array is true, using the
+// truthiness rules of bool. An empty array is false.
+func (p *Any) Execute(args []interface{}) (interface{}, error) {
+	list, err := parseLogicList(args, AnyRequiresListError)
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range list {
+		if Truthy(item) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func init() {
+	primitive_services.RegisterPrimitive(&Any{})
+}
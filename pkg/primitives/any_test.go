@@ -0,0 +1,24 @@
+package primitives
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAny(t *testing.T) {
+	got, err := (&Any{}).Execute([]interface{}{[]interface{}{false, "", "x"}})
+	assert.NoError(t, err)
+	assert.Equal(t, true, got)
+
+	got, err = (&Any{}).Execute([]interface{}{[]interface{}{false, nil, 0.0}})
+	assert.NoError(t, err)
+	assert.Equal(t, false, got)
+
+	got, err = (&Any{}).Execute([]interface{}{[]interface{}{}})
+	assert.NoError(t, err)
+	assert.Equal(t, false, got)
+
+	_, err = (&Any{}).Execute([]interface{}{})
+	assert.ErrorIs(t, err, AnyRequiresListError)
+}
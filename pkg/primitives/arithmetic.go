@@ -0,0 +1,208 @@
+package primitives
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"strings"
+
+	"github.com/hyperifyio/gnd/pkg/parsers"
+)
+
+var (
+	ArithmeticInvalidArgumentError = errors.New("argument must be a number or numeric string")
+	ArithmeticOverflowError        = errors.New("result out of range")
+	ArithmeticDivisionByZeroError  = errors.New("division by zero")
+)
+
+var (
+	int64Type   = reflect.TypeOf(int64(0))
+	uint64Type  = reflect.TypeOf(uint64(0))
+	float64Type = reflect.TypeOf(float64(0))
+)
+
+// ToNumber converts an arithmetic operand into a Go number. Numbers are
+// returned as they are and strings are trimmed and parsed as number literals
+// into int64 or float64. NaN and infinities are not numbers.
+func ToNumber(v interface{}) (interface{}, error) {
+	if s, ok := v.(string); ok {
+		value, ok, err := parsers.ParseNumberLiteral(strings.TrimSpace(s))
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q", ArithmeticOverflowError, s)
+		}
+		if !ok {
+			return nil, fmt.Errorf("%w: %q", ArithmeticInvalidArgumentError, s)
+		}
+		return value, nil
+	}
+	if _, ok := ToBigFloat(v); !ok {
+		return nil, fmt.Errorf("%w: %v (%T)", ArithmeticInvalidArgumentError, v, v)
+	}
+	return v, nil
+}
+
+// ToNumbers converts every operand with ToNumber
+func ToNumbers(args []interface{}) ([]interface{}, error) {
+	numbers := make([]interface{}, len(args))
+	for i, arg := range args {
+		n, err := ToNumber(arg)
+		if err != nil {
+			return nil, err
+		}
+		numbers[i] = n
+	}
+	return numbers, nil
+}
+
+// PromotedType returns the type of the result of arithmetic over numbers.
+// Numbers of a single type keep that type. Mixed types are promoted to
+// float64 if any of them is a float, to uint64 if all of them are unsigned
+// integers, and to int64 otherwise.
+func PromotedType(numbers []interface{}) reflect.Type {
+	first := reflect.TypeOf(numbers[0])
+	same, anyFloat, allUnsigned := true, false, true
+	for _, n := range numbers {
+		t := reflect.TypeOf(n)
+		if t != first {
+			same = false
+		}
+		switch t.Kind() {
+		case reflect.Float32, reflect.Float64:
+			anyFloat = true
+			allUnsigned = false
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			allUnsigned = false
+		}
+	}
+	switch {
+	case same:
+		return first
+	case anyFloat:
+		return float64Type
+	case allUnsigned:
+		return uint64Type
+	default:
+		return int64Type
+	}
+}
+
+// isFloatType returns true for float32 and float64
+func isFloatType(t reflect.Type) bool {
+	return t.Kind() == reflect.Float32 || t.Kind() == reflect.Float64
+}
+
+// toBigInt converts a Go integer into a big.Int
+func toBigInt(n interface{}) *big.Int {
+	rv := reflect.ValueOf(n)
+	switch rv.Kind() {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return new(big.Int).SetUint64(rv.Uint())
+	default:
+		return big.NewInt(rv.Int())
+	}
+}
+
+// toFloat64 converts a Go number into a float64
+func toFloat64(n interface{}) float64 {
+	rv := reflect.ValueOf(n)
+	switch rv.Kind() {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(rv.Uint())
+	case reflect.Float32, reflect.Float64:
+		return rv.Float()
+	default:
+		return float64(rv.Int())
+	}
+}
+
+// fromBigInt converts x into the integer type t, failing if it does not fit
+func fromBigInt(x *big.Int, t reflect.Type) (interface{}, error) {
+	value := reflect.New(t).Elem()
+	bits := uint(t.Bits())
+	switch t.Kind() {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if x.Sign() < 0 || x.BitLen() > int(bits) {
+			return nil, fmt.Errorf("%w: %s does not fit in %s", ArithmeticOverflowError, x, t)
+		}
+		value.SetUint(x.Uint64())
+	default:
+		limit := new(big.Int).Lsh(big.NewInt(1), bits-1)
+		if x.Cmp(new(big.Int).Neg(limit)) < 0 || x.Cmp(limit) >= 0 {
+			return nil, fmt.Errorf("%w: %s does not fit in %s", ArithmeticOverflowError, x, t)
+		}
+		value.SetInt(x.Int64())
+	}
+	return value.Interface(), nil
+}
+
+// fromFloat64 converts f into the float type t, failing if it is not finite
+func fromFloat64(f float64, t reflect.Type) (interface{}, error) {
+	if t.Kind() == reflect.Float32 {
+		f32 := float32(f)
+		if math.IsInf(float64(f32), 0) || math.IsNaN(float64(f32)) {
+			return nil, fmt.Errorf("%w: %v does not fit in float32", ArithmeticOverflowError, f)
+		}
+		return f32, nil
+	}
+	if math.IsInf(f, 0) || math.IsNaN(f) {
+		return nil, fmt.Errorf("%w: %v", ArithmeticOverflowError, f)
+	}
+	return f, nil
+}
+
+// ConvertNumber converts a number into the type t as returned by PromotedType
+func ConvertNumber(n interface{}, t reflect.Type) (interface{}, error) {
+	if isFloatType(t) {
+		return fromFloat64(toFloat64(n), t)
+	}
+	return fromBigInt(toBigInt(n), t)
+}
+
+// arithmeticOp is a binary operation on integers and on floats
+type arithmeticOp struct {
+	ints   func(a, b *big.Int) (*big.Int, error)
+	floats func(a, b float64) (float64, error)
+}
+
+// foldArithmetic applies op from left to right over the operands, computing
+// in the promoted type of all operands
+func foldArithmetic(name string, args []interface{}, op arithmeticOp) (interface{}, error) {
+	numbers, err := ToNumbers(args)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	t := PromotedType(numbers)
+
+	if isFloatType(t) {
+		acc := toFloat64(numbers[0])
+		for _, n := range numbers[1:] {
+			if acc, err = op.floats(acc, toFloat64(n)); err != nil {
+				return nil, fmt.Errorf("%s: %w", name, err)
+			}
+		}
+		result, err := fromFloat64(acc, t)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		return result, nil
+	}
+
+	acc := toBigInt(numbers[0])
+	for _, n := range numbers[1:] {
+		if acc, err = op.ints(acc, toBigInt(n)); err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		// Intermediate results are checked so that overflow is reported at
+		// the step that causes it
+		if _, err := fromBigInt(acc, t); err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+	}
+	result, err := fromBigInt(acc, t)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	return result, nil
+}
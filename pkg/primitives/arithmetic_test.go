@@ -0,0 +1,70 @@
+package primitives
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToNumber(t *testing.T) {
+	for _, tc := range []struct {
+		arg  interface{}
+		want interface{}
+	}{
+		{int8(1), int8(1)},
+		{uint(1), uint(1)},
+		{1.5, 1.5},
+		{" 42 ", int64(42)},
+		{"-0x10", int64(-16)},
+		{"2.5", 2.5},
+	} {
+		got, err := ToNumber(tc.arg)
+		assert.NoError(t, err, "%v", tc.arg)
+		assert.Equal(t, tc.want, got, "%v", tc.arg)
+	}
+
+	for _, arg := range []interface{}{"", "abc", "1e5", nil, true, math.NaN(), math.Inf(-1), []interface{}{int64(1)}} {
+		_, err := ToNumber(arg)
+		assert.ErrorIs(t, err, ArithmeticInvalidArgumentError, "%v", arg)
+	}
+
+	_, err := ToNumber("99999999999999999999")
+	assert.ErrorIs(t, err, ArithmeticOverflowError)
+}
+
+func TestPromotedType(t *testing.T) {
+	for _, tc := range []struct {
+		numbers []interface{}
+		want    interface{}
+	}{
+		{[]interface{}{int16(1), int16(2)}, int16(0)},
+		{[]interface{}{float32(1), float32(2)}, float32(0)},
+		{[]interface{}{int16(1), int32(2)}, int64(0)},
+		{[]interface{}{uint8(1), uint(2)}, uint64(0)},
+		{[]interface{}{uint8(1), int8(2)}, int64(0)},
+		{[]interface{}{float32(1), int64(2)}, float64(0)},
+	} {
+		assert.Equal(t, reflect.TypeOf(tc.want), PromotedType(tc.numbers), "%v", tc.numbers)
+	}
+}
+
+func TestConvertNumber(t *testing.T) {
+	got, err := ConvertNumber(uint64(255), reflect.TypeOf(uint8(0)))
+	assert.NoError(t, err)
+	assert.Equal(t, uint8(255), got)
+
+	_, err = ConvertNumber(uint64(256), reflect.TypeOf(uint8(0)))
+	assert.ErrorIs(t, err, ArithmeticOverflowError)
+
+	_, err = ConvertNumber(uint64(math.MaxUint64), reflect.TypeOf(int64(0)))
+	assert.ErrorIs(t, err, ArithmeticOverflowError)
+
+	got, err = ConvertNumber(int64(-3), reflect.TypeOf(float64(0)))
+	assert.NoError(t, err)
+	assert.Equal(t, -3.0, got)
+
+	_, err = ConvertNumber(math.MaxFloat64, reflect.TypeOf(float32(0)))
+	assert.ErrorIs(t, err, ArithmeticOverflowError)
+}
@@ -27,13 +27,8 @@ func (a *Async) Execute(args []interface{}) (interface{}, error) {
 		return nil, AsyncErrNoArguments
 	}
 
-	var routine []*parsers.Instruction
-	switch v := args[0].(type) {
-	case []*parsers.Instruction:
-		routine = v
-	case *parsers.Instruction:
-		routine = []*parsers.Instruction{v}
-	default:
+	routine, ok := ToRoutine(args[0])
+	if !ok {
 		return nil, AsyncErrInvalidRoutine
 	}
 
@@ -44,7 +39,7 @@ func (a *Async) Execute(args []interface{}) (interface{}, error) {
 func (a *Async) HandleBlockSuccessResult(
 	result interface{},
 	i primitive_types.Interpreter,
-	_ *parsers.PropertyRef,
+	destination *parsers.PropertyRef,
 	_ []*parsers.Instruction,
 ) (interface{}, error) {
 
@@ -55,18 +50,21 @@ func (a *Async) HandleBlockSuccessResult(
 		return result, nil // nothing to do
 	}
 
-	// Spawn a child interpreter with "_" initialised to the argument list.
-	interp := i.NewInterpreterWithParent(
+	// Mark running, then spawn a child interpreter with "_" initialised to
+	// the argument list and the context that cancel $task cancels.
+	ctx := task.Start(i.GetContext())
+	interp := i.NewInterpreterWithContext(
+		ctx,
 		i.GetScriptDir(),
 		map[string]interface{}{
 			"_": task.Args,
 		},
 	)
 
-	// mark running, then launch worker
-	task.SetState(TaskStateRunning)
+	// launch worker
 	go func(childInterpreter primitive_types.Interpreter, name string, t *Task) {
 		val, err := HandleTaskResult(childInterpreter, name, t)
+		// Both fail without effect if the task was cancelled meanwhile
 		if err != nil {
 			t.SetError(err)
 		} else {
@@ -74,6 +72,9 @@ func (a *Async) HandleBlockSuccessResult(
 		}
 	}(interp, source, task)
 
+	if destination != nil {
+		i.SetSlot(destination.Name, task)
+	}
 	return task, nil
 }
 
@@ -1,11 +1,14 @@
 package primitives
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"sync/atomic"
+	"time"
 
 	"github.com/hyperifyio/gnd/pkg/parsers"
+	"github.com/hyperifyio/gnd/pkg/runtime_errors"
 )
 
 const (
@@ -14,12 +17,14 @@ const (
 	TaskStateRunningStr   = "running"
 	TaskStateCompletedStr = "completed"
 	TaskStateErrorStr     = "error"
+	TaskStateCancelledStr = "cancelled"
 )
 
 var (
 	// Task state errors
 	ErrTaskInvalidStateForCompletion = errors.New("task: cannot complete task in invalid state")
 	ErrTaskInvalidStateForError      = errors.New("task: cannot set error on task in invalid state")
+	ErrTaskCancelled                 = errors.New("task: cancelled")
 )
 
 // TaskState is an internal numeric code (int32) so atomic operations can be
@@ -33,6 +38,7 @@ const (
 	TaskStateRunning
 	TaskStateCompleted
 	TaskStateError
+	TaskStateCancelled
 )
 
 // String returns the canonical text label for a TaskState.
@@ -46,6 +52,8 @@ func (s TaskState) String() string {
 		return TaskStateCompletedStr
 	case TaskStateError:
 		return TaskStateErrorStr
+	case TaskStateCancelled:
+		return TaskStateCancelledStr
 	default:
 		return fmt.Sprintf("unknown(%d)", s)
 	}
@@ -63,6 +71,8 @@ func ParseTaskState(txt string) (TaskState, bool) {
 		return TaskStateCompleted, true
 	case TaskStateErrorStr:
 		return TaskStateError, true
+	case TaskStateCancelledStr:
+		return TaskStateCancelled, true
 	default:
 		return 0, false
 	}
@@ -80,12 +90,14 @@ type taskResult struct {
 // • state   -> accessed with atomic helpers below
 // • done    -> receive-only by Await; send-once by worker
 // • Routine / Args are read-only after construction
+// • cancel  -> written once by Start before the task is shared
 type Task struct {
 	Routine []*parsers.Instruction
 	Args    []interface{}
 
-	done  chan taskResult // closed exactly once
-	state int32           // holds a TaskState code
+	done   chan taskResult // closed exactly once
+	state  int32           // holds a TaskState code
+	cancel context.CancelFunc
 
 	result interface{} // written once by worker
 	err    error       // written once by worker
@@ -113,17 +125,46 @@ func (t *Task) SetState(s TaskState) {
 	atomic.StoreInt32(&t.state, int32(s))
 }
 
+// Start marks the task as running and returns the context its routine must
+// run with. The context is cancelled by Cancel, and released when the task
+// finishes. Async calls Start before the task is shared.
+func (t *Task) Start(parent context.Context) context.Context {
+	ctx, cancel := context.WithCancel(parent)
+	t.cancel = cancel
+	t.SetState(TaskStateRunning)
+	return ctx
+}
+
+// finish moves a pending or running task to state and wakes up the awaiting
+// goroutines. The transition is atomic, so a worker finishing at the same
+// time as Cancel cannot close done twice. Returns false if the task had
+// already finished.
+func (t *Task) finish(state TaskState, result interface{}, err error) bool {
+	for {
+		current := t.GetState()
+		if current != TaskStatePending && current != TaskStateRunning {
+			return false
+		}
+		if atomic.CompareAndSwapInt32(&t.state, int32(current), int32(state)) {
+			break
+		}
+	}
+	t.result = result
+	t.err = err
+	close(t.done)
+	if t.cancel != nil {
+		t.cancel()
+	}
+	return true
+}
+
 // SetCompleted marks the task as completed with the given result.
 // This should only be called by the task's worker goroutine.
 // Returns an error if the task is not in a valid state (pending or running).
 func (t *Task) SetCompleted(result interface{}) error {
-	state := t.GetState()
-	if state != TaskStatePending && state != TaskStateRunning {
+	if !t.finish(TaskStateCompleted, result, nil) {
 		return ErrTaskInvalidStateForCompletion
 	}
-	t.result = result
-	t.SetState(TaskStateCompleted)
-	close(t.done)
 	return nil
 }
 
@@ -131,16 +172,22 @@ func (t *Task) SetCompleted(result interface{}) error {
 // This should only be called by the task's worker goroutine.
 // Returns an error if the task is not in a valid state (pending or running).
 func (t *Task) SetError(err error) error {
-	state := t.GetState()
-	if state != TaskStatePending && state != TaskStateRunning {
+	if !t.finish(TaskStateError, nil, err) {
 		return ErrTaskInvalidStateForError
 	}
-	t.err = err
-	t.SetState(TaskStateError)
-	close(t.done)
 	return nil
 }
 
+// Cancel marks a pending or running task as cancelled and cancels the
+// context of its routine, so it stops before its next instruction and
+// blocking primitives such as prompt and wait return early. Awaiting the task
+// fails with an error value with the code cancelled. Returns false if the
+// task had already finished.
+func (t *Task) Cancel() bool {
+	err := runtime_errors.NewErrorValue(runtime_errors.CancelledErrorCode, ErrTaskCancelled.Error(), nil, ErrTaskCancelled)
+	return t.finish(TaskStateCancelled, nil, err)
+}
+
 // GetTask extracts a *Task from an arbitrary value.
 func GetTask(v interface{}) (*Task, bool) {
 	t, ok := v.(*Task)
@@ -157,3 +204,50 @@ func (t *Task) Await() (interface{}, error) {
 	<-t.done
 	return t.result, t.err
 }
+
+// awaitTask waits for task until ctx is done. If timeout is positive and the
+// task does not finish in time, the error is an ErrorValue with the code
+// timeout that wraps timeoutErr. The task keeps running after a timeout.
+func awaitTask(ctx context.Context, task *Task, timeout time.Duration, timeoutErr error) (interface{}, error) {
+	waitCtx := ctx
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		waitCtx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	select {
+	case <-task.done:
+		return task.result, task.err
+	case <-waitCtx.Done():
+	}
+	// A task that finished as the wait ended is not timed out
+	select {
+	case <-task.done:
+		return task.result, task.err
+	default:
+	}
+	if ctx.Err() != nil {
+		return nil, runtime_errors.NewContextError(ctx.Err())
+	}
+	message := fmt.Sprintf("%s after %s", timeoutErr, timeout)
+	return nil, runtime_errors.NewErrorValue(runtime_errors.TimeoutErrorCode, message, nil, timeoutErr)
+}
+
+// millisecondsOf returns a number, or a numeric string, as a duration in
+// milliseconds. Fractions of a millisecond are kept.
+func millisecondsOf(value interface{}) (time.Duration, bool) {
+	// Only numbers and strings are converted, as the error of ToNumber
+	// formats the value, which would read a task while its worker writes it
+	switch value.(type) {
+	case string, float32, float64,
+		int, int8, int16, int32, int64,
+		uint, uint8, uint16, uint32, uint64:
+	default:
+		return 0, false
+	}
+	n, err := ToNumber(value)
+	if err != nil {
+		return 0, false
+	}
+	return time.Duration(toFloat64(n) * float64(time.Millisecond)), true
+}
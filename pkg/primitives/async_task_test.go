@@ -1,10 +1,13 @@
 package primitives
 
 import (
+	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/hyperifyio/gnd/pkg/parsers"
+	"github.com/hyperifyio/gnd/pkg/runtime_errors"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -66,6 +69,18 @@ func TestTask_GetState(t *testing.T) {
 
 	task.SetState(TaskStateError)
 	assert.Equal(t, TaskStateError, task.GetState())
+
+	task.SetState(TaskStateCancelled)
+	assert.Equal(t, TaskStateCancelled, task.GetState())
+}
+
+func TestTaskState_String(t *testing.T) {
+	for _, state := range []TaskState{TaskStatePending, TaskStateRunning, TaskStateCompleted, TaskStateError, TaskStateCancelled} {
+		parsed, ok := ParseTaskState(state.String())
+		assert.True(t, ok)
+		assert.Equal(t, state, parsed)
+	}
+	assert.Equal(t, "cancelled", TaskStateCancelled.String())
 }
 
 func TestTask_SetCompleted(t *testing.T) {
@@ -207,6 +222,98 @@ func TestTask_Await(t *testing.T) {
 	})
 }
 
+func TestTask_Cancel(t *testing.T) {
+	t.Run("cancel running task", func(t *testing.T) {
+		task := NewTask([]*parsers.Instruction{{Opcode: "test"}}, nil)
+		ctx := task.Start(context.Background())
+		assert.Equal(t, TaskStateRunning, task.GetState())
+
+		assert.True(t, task.Cancel())
+		assert.Equal(t, TaskStateCancelled, task.GetState())
+		assert.ErrorIs(t, ctx.Err(), context.Canceled)
+
+		result, err := task.Await()
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, ErrTaskCancelled)
+		assert.Equal(t, runtime_errors.CancelledErrorCode, runtime_errors.ToErrorValue(err).Code)
+
+		// The worker finishing later does not change the outcome
+		assert.Equal(t, ErrTaskInvalidStateForCompletion, task.SetCompleted("late"))
+		assert.Equal(t, ErrTaskInvalidStateForError, task.SetError(errors.New("late")))
+		assert.False(t, task.Cancel())
+		assert.Equal(t, TaskStateCancelled, task.GetState())
+	})
+
+	t.Run("cancel finished task", func(t *testing.T) {
+		task := NewTask([]*parsers.Instruction{{Opcode: "test"}}, nil)
+		ctx := task.Start(context.Background())
+		assert.NoError(t, task.SetCompleted("done"))
+		// The context is released when the task finishes
+		assert.Error(t, ctx.Err())
+
+		assert.False(t, task.Cancel())
+		assert.Equal(t, TaskStateCompleted, task.GetState())
+		result, err := task.Await()
+		assert.NoError(t, err)
+		assert.Equal(t, "done", result)
+	})
+
+	t.Run("concurrent cancel and completion", func(t *testing.T) {
+		for n := 0; n < 100; n++ {
+			task := NewTask([]*parsers.Instruction{{Opcode: "test"}}, nil)
+			task.Start(context.Background())
+			go task.SetCompleted("done")
+			task.Cancel()
+			_, _ = task.Await()
+			state := task.GetState()
+			assert.True(t, state == TaskStateCompleted || state == TaskStateCancelled, state.String())
+		}
+	})
+}
+
+func TestAwaitTask(t *testing.T) {
+	task := NewTask([]*parsers.Instruction{{Opcode: "test"}}, nil)
+	task.Start(context.Background())
+
+	_, err := awaitTask(context.Background(), task, 10*time.Millisecond, AwaitErrTimeout)
+	assert.ErrorIs(t, err, AwaitErrTimeout)
+	assert.Equal(t, runtime_errors.TimeoutErrorCode, runtime_errors.ToErrorValue(err).Code)
+	assert.Equal(t, TaskStateRunning, task.GetState())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = awaitTask(ctx, task, 0, AwaitErrTimeout)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, runtime_errors.CancelledErrorCode, runtime_errors.ToErrorValue(err).Code)
+
+	assert.NoError(t, task.SetCompleted("done"))
+	result, err := awaitTask(ctx, task, 0, AwaitErrTimeout)
+	assert.NoError(t, err)
+	assert.Equal(t, "done", result)
+}
+
+func TestMillisecondsOf(t *testing.T) {
+	tests := []struct {
+		value interface{}
+		want  time.Duration
+		ok    bool
+	}{
+		{int64(20), 20 * time.Millisecond, true},
+		{0.5, 500 * time.Microsecond, true},
+		{float32(1.5), 1500 * time.Microsecond, true},
+		{uint8(3), 3 * time.Millisecond, true},
+		{"250", 250 * time.Millisecond, true},
+		{"soon", 0, false},
+		{nil, 0, false},
+		{NewTask(nil, nil), 0, false},
+	}
+	for _, tt := range tests {
+		got, ok := millisecondsOf(tt.value)
+		assert.Equal(t, tt.ok, ok, "%v", tt.value)
+		assert.Equal(t, tt.want, got, "%v", tt.value)
+	}
+}
+
 func TestGetTask(t *testing.T) {
 	tests := []struct {
 		name     string
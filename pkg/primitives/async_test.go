@@ -1,6 +1,8 @@
 package primitives
 
 import (
+	"context"
+	"regexp"
 	"testing"
 
 	"github.com/hyperifyio/gnd/pkg/parsers"
@@ -164,6 +166,7 @@ func TestAsync_HandleBlockSuccessResult(t *testing.T) {
 type MockInterpreter struct{}
 
 func (m *MockInterpreter) GetScriptDir() string                        { return "" }
+func (m *MockInterpreter) GetContext() context.Context                 { return context.Background() }
 func (m *MockInterpreter) GetLogIndent() int                           { return 0 }
 func (m *MockInterpreter) SetLogIndent(indent int)                     {}
 func (m *MockInterpreter) LogDebug(format string, args ...interface{}) {}
@@ -179,6 +182,12 @@ func (m *MockInterpreter) GetSubroutineInstructions(path string) ([]*parsers.Ins
 	return nil, nil
 }
 func (m *MockInterpreter) ResolveOpcode(opcode string) string { return opcode }
+func (m *MockInterpreter) CompileRegexp(pattern string) (*regexp.Regexp, error) {
+	return regexp.Compile(pattern)
+}
 func (m *MockInterpreter) NewInterpreterWithParent(scriptDir string, initialSlots map[string]interface{}) primitive_types.Interpreter {
 	return m
 }
+func (m *MockInterpreter) NewInterpreterWithContext(ctx context.Context, scriptDir string, initialSlots map[string]interface{}) primitive_types.Interpreter {
+	return m
+}
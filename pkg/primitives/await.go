@@ -1,7 +1,9 @@
 package primitives
 
 import (
+	"context"
 	"errors"
+	"time"
 
 	"github.com/hyperifyio/gnd/pkg/primitive_services"
 	"github.com/hyperifyio/gnd/pkg/primitive_types"
@@ -14,24 +16,39 @@ func init() {
 var (
 	AwaitErrMissingTask    = errors.New("await: missing task argument")
 	AwaitErrInvalidOperand = errors.New("await: operand is not a task")
-	AwaitErrTooManyArgs    = errors.New("await: expects 1 argument")
+	AwaitErrTooManyArgs    = errors.New("await: expects a task and an optional timeout")
+	AwaitErrInvalidTimeout = errors.New("await: timeout must be a positive number of milliseconds")
+	AwaitErrTimeout        = errors.New("await: timed out")
 )
 
 type Await struct{}
 
-var _ primitive_types.Primitive = &Await{}
+var _ primitive_types.ContextPrimitive = &Await{}
 
 func (a *Await) Name() string { return "/gnd/await" }
 
 func (a *Await) Execute(args []interface{}) (interface{}, error) {
+	return a.ExecuteWithContext(context.Background(), args)
+}
+
+// ExecuteWithContext waits for the task, or until the optional timeout in
+// milliseconds has passed or ctx is done
+func (a *Await) ExecuteWithContext(ctx context.Context, args []interface{}) (interface{}, error) {
 
 	var operand interface{}
+	var timeout time.Duration
 	switch len(args) {
 	case 0:
 		// interpreter should have placed "_" in args already; defensive fallback
 		return nil, AwaitErrMissingTask
 	case 1:
 		operand = args[0]
+	case 2:
+		operand = args[0]
+		var ok bool
+		if timeout, ok = millisecondsOf(args[1]); !ok || timeout <= 0 {
+			return nil, AwaitErrInvalidTimeout
+		}
 	default:
 		return nil, AwaitErrTooManyArgs
 	}
@@ -41,7 +58,7 @@ func (a *Await) Execute(args []interface{}) (interface{}, error) {
 		return nil, AwaitErrInvalidOperand
 	}
 
-	res, err := task.Await()
+	res, err := awaitTask(ctx, task, timeout, AwaitErrTimeout)
 	if err != nil {
 		// Re-throw inside VM semantics
 		return nil, err
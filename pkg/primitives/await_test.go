@@ -33,11 +33,21 @@ func TestAwait_Execute(t *testing.T) {
 			name: "too many arguments",
 			args: []interface{}{
 				NewTask([]*parsers.Instruction{{Opcode: "test"}}, nil),
+				100.0,
 				"extra",
 			},
 			wantErr:     true,
 			errContains: AwaitErrTooManyArgs.Error(),
 		},
+		{
+			name: "invalid timeout",
+			args: []interface{}{
+				NewTask([]*parsers.Instruction{{Opcode: "test"}}, nil),
+				"extra",
+			},
+			wantErr:     true,
+			errContains: AwaitErrInvalidTimeout.Error(),
+		},
 		{
 			name: "successful task completion",
 			args: []interface{}{
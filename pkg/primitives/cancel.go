@@ -0,0 +1,47 @@
+package primitives
+
+import (
+	"errors"
+
+	"github.com/hyperifyio/gnd/pkg/primitive_services"
+	"github.com/hyperifyio/gnd/pkg/primitive_types"
+)
+
+// Predefined errors
+var (
+	CancelErrNoArguments     = errors.New("cancel: requires a task")
+	CancelErrInvalidArgument = errors.New("cancel: argument must be a task")
+	CancelErrTooManyArgs     = errors.New("cancel: too many arguments")
+)
+
+// Cancel represents the cancel primitive
+type Cancel struct{}
+
+var _ primitive_types.Primitive = &Cancel{}
+
+// Name returns the name of the primitive
+func (c *Cancel) Name() string {
+	return "/gnd/cancel"
+}
+
+// Execute cancels a task and returns true, or false if the task had already
+// finished
+func (c *Cancel) Execute(args []interface{}) (interface{}, error) {
+	if len(args) == 0 {
+		return nil, CancelErrNoArguments
+	}
+
+	if len(args) > 1 {
+		return nil, CancelErrTooManyArgs
+	}
+
+	task, ok := GetTask(args[0])
+	if !ok {
+		return nil, CancelErrInvalidArgument
+	}
+	return task.Cancel(), nil
+}
+
+func init() {
+	primitive_services.RegisterPrimitive(&Cancel{})
+}
@@ -0,0 +1,107 @@
+package primitives_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hyperifyio/gnd/pkg/primitives"
+	"github.com/hyperifyio/gnd/pkg/runtime_errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCancel(t *testing.T) {
+	dir := t.TempDir()
+	slots := map[string]interface{}{
+		"slow": parseRoutine(t, "wait 200\nwrite-file \"marker.txt\" \"written\"\n"),
+	}
+
+	start := time.Now()
+	got, err := runBlockIn(t, dir, slots, `$task async $slow
+$cancelled cancel $task
+$again cancel $task
+$outcome wait $task
+$e get $outcome 1
+$code error-code $e
+$state status $task
+$result let [$cancelled $again $code $state]
+`)
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{true, false, "cancelled", primitives.TaskStateCancelled}, got)
+	assert.Less(t, time.Since(start), 200*time.Millisecond)
+
+	// The routine of the task stops instead of running to its end
+	time.Sleep(300 * time.Millisecond)
+	_, err = os.Stat(filepath.Join(dir, "marker.txt"))
+	assert.True(t, os.IsNotExist(err))
+
+	_, err = runBlockIn(t, dir, slots, "$task async $slow\ncancel $task\nawait $task\n")
+	assert.ErrorIs(t, err, primitives.ErrTaskCancelled)
+}
+
+func TestCancelFinishedTask(t *testing.T) {
+	slots := map[string]interface{}{"r": concatRoutine()}
+	got, err := runBlock(t, slots, "$task async $r \"a\" \"b\"\nawait $task\n$result cancel $task\n")
+	assert.NoError(t, err)
+	assert.Equal(t, false, got)
+}
+
+func TestCancelErrors(t *testing.T) {
+	_, err := runBlock(t, nil, `$result cancel "task"`)
+	assert.ErrorIs(t, err, primitives.CancelErrInvalidArgument)
+
+	cancel := &primitives.Cancel{}
+	_, err = cancel.Execute(nil)
+	assert.ErrorIs(t, err, primitives.CancelErrNoArguments)
+	_, err = cancel.Execute([]interface{}{primitives.NewTask(nil, nil), "extra"})
+	assert.ErrorIs(t, err, primitives.CancelErrTooManyArgs)
+}
+
+func TestTaskTimeouts(t *testing.T) {
+	dir := t.TempDir()
+	slots := map[string]interface{}{"slow": parseRoutine(t, "wait 5000\n")}
+
+	// wait reports a timeout like a failed task, and the task keeps running
+	got, err := runBlockIn(t, dir, slots, `$task async $slow
+$outcome wait $task 20
+$e get $outcome 1
+$code error-code $e
+$state status $task
+cancel $task
+$result let [$code $state]
+`)
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{"timeout", primitives.TaskStateRunning}, got)
+
+	_, err = runBlockIn(t, dir, slots, "$task async $slow\n$r await $task 20\n")
+	assert.ErrorIs(t, err, primitives.AwaitErrTimeout)
+	assert.Equal(t, runtime_errors.TimeoutErrorCode, runtime_errors.ToErrorValue(err).Code)
+
+	// Timeouts below a millisecond are not rounded down to zero
+	_, err = runBlockIn(t, dir, slots, "$task async $slow\n$r await $task 0.5\n")
+	assert.ErrorIs(t, err, primitives.AwaitErrTimeout)
+	assert.Contains(t, err.Error(), "after 500µs")
+
+	got, err = runBlockIn(t, dir, slots, "$task async $slow\n$outcome wait $task \"0.5\"\n$result first $outcome\n")
+	assert.NoError(t, err)
+	assert.Equal(t, false, got)
+}
+
+func TestCancelNestedTask(t *testing.T) {
+	dir := t.TempDir()
+	allowRun(t, "sh")
+	slots := map[string]interface{}{
+		"inner": parseRoutine(t, "run sh [\"-c\" \"sleep 0.3; touch marker.txt\"]\n"),
+		"outer": parseRoutine(t, "$inner first _\n$task async $inner\nawait $task\n"),
+	}
+
+	got, err := runBlockIn(t, dir, slots, "$task async $outer $inner\nwait 50\ncancel $task\n$result wait $task\n")
+	assert.NoError(t, err)
+	assert.Equal(t, false, got.([]interface{})[0])
+
+	// Cancelling a task also stops the tasks and programs it started
+	time.Sleep(500 * time.Millisecond)
+	_, err = os.Stat(filepath.Join(dir, "marker.txt"))
+	assert.True(t, os.IsNotExist(err))
+}
@@ -122,16 +122,22 @@ subtract _ [5 3]`,
 					Destination: parsers.NewPropertyRef("_"),
 					Arguments: []interface{}{
 						parsers.NewPropertyRef("_"),
-						[]interface{}{"1", "2"},
+						[]interface{}{int64(1), int64(2)},
 					},
+					File:   filepath.Join(tempDir, "math.gnd"),
+					Line:   1,
+					Column: 1,
 				},
 				{
 					Opcode:      "subtract",
 					Destination: parsers.NewPropertyRef("_"),
 					Arguments: []interface{}{
 						parsers.NewPropertyRef("_"),
-						[]interface{}{"5", "3"},
+						[]interface{}{int64(5), int64(3)},
 					},
+					File:   filepath.Join(tempDir, "math.gnd"),
+					Line:   2,
+					Column: 1,
 				},
 			},
 		},
@@ -146,6 +152,9 @@ subtract _ [5 3]`,
 						parsers.NewPropertyRef("_"),
 						nullInstructionListInterface,
 					},
+					File:   filepath.Join(tempDir, "add.gnd"),
+					Line:   1,
+					Column: 1,
 				},
 			},
 		},
@@ -172,16 +181,22 @@ subtract _ [5 3]`,
 					Destination: parsers.NewPropertyRef("_"),
 					Arguments: []interface{}{
 						parsers.NewPropertyRef("_"),
-						[]interface{}{"1", "2"},
+						[]interface{}{int64(1), int64(2)},
 					},
+					File:   filepath.Join(tempDir, "math.gnd"),
+					Line:   1,
+					Column: 1,
 				},
 				{
 					Opcode:      "subtract",
 					Destination: parsers.NewPropertyRef("_"),
 					Arguments: []interface{}{
 						parsers.NewPropertyRef("_"),
-						[]interface{}{"5", "3"},
+						[]interface{}{int64(5), int64(3)},
 					},
+					File:   filepath.Join(tempDir, "math.gnd"),
+					Line:   2,
+					Column: 1,
 				},
 				{
 					Opcode:      "concat",
@@ -190,6 +205,9 @@ subtract _ [5 3]`,
 						parsers.NewPropertyRef("_"),
 						[]interface{}{"hello", "world"},
 					},
+					File:   filepath.Join(tempDir, "string.gnd"),
+					Line:   1,
+					Column: 1,
 				},
 			},
 		},
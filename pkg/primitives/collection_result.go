@@ -0,0 +1,66 @@
+package primitives
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/hyperifyio/gnd/pkg/parsers"
+)
+
+var CollectionRequiresArgumentsError = errors.New("requires a routine and a list")
+var CollectionRoutineInvalidError = errors.New("routine must be an instruction array or instruction")
+var CollectionListInvalidError = errors.New("list must be an array")
+var CollectionTooManyArgumentsError = errors.New("too many arguments")
+
+// CollectionResult represents a request to run a routine over the elements
+// of a list. It is returned by the higher-order primitives such as map and
+// handled by their BlockSuccessResultHandler.
+type CollectionResult struct {
+	// Routine is the instruction array to execute per element
+	Routine []*parsers.Instruction
+	// List is the array to iterate over
+	List []interface{}
+	// Args are the optional arguments after the list, e.g. the initial
+	// accumulator of reduce
+	Args []interface{}
+}
+
+// String returns a string representation of the CollectionResult
+func (c *CollectionResult) String() string {
+	return fmt.Sprintf("CollectionResult{routine: %v, list: %v, args: %v}", c.Routine, c.List, c.Args)
+}
+
+// NewCollectionResult creates a new CollectionResult
+func NewCollectionResult(routine []*parsers.Instruction, list []interface{}, args []interface{}) *CollectionResult {
+	return &CollectionResult{
+		Routine: routine,
+		List:    list,
+		Args:    args,
+	}
+}
+
+// GetCollectionResult extracts the CollectionResult from a value if it is one
+func GetCollectionResult(v interface{}) (*CollectionResult, bool) {
+	result, ok := v.(*CollectionResult)
+	return result, ok
+}
+
+// ParseCollectionArgs parses "routine list [args...]" for the primitive name,
+// allowing at most maxArgs arguments after the list
+func ParseCollectionArgs(name string, args []interface{}, maxArgs int) (*CollectionResult, error) {
+	if len(args) < 2 {
+		return nil, fmt.Errorf("%s: %w", name, CollectionRequiresArgumentsError)
+	}
+	if len(args) > 2+maxArgs {
+		return nil, fmt.Errorf("%s: %w", name, CollectionTooManyArgumentsError)
+	}
+	routine, ok := ToRoutine(args[0])
+	if !ok {
+		return nil, fmt.Errorf("%s: %w", name, CollectionRoutineInvalidError)
+	}
+	list, ok := args[1].([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%s: %w: %T", name, CollectionListInvalidError, args[1])
+	}
+	return NewCollectionResult(routine, list, args[2:]), nil
+}
@@ -0,0 +1,34 @@
+package primitives
+
+import (
+	"testing"
+
+	"github.com/hyperifyio/gnd/pkg/parsers"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseCollectionArgs(t *testing.T) {
+	routine := []*parsers.Instruction{parsers.NewInstruction("/gnd/let", parsers.NewPropertyRef("_"), nil)}
+	list := []interface{}{int64(1), int64(2)}
+
+	c, err := ParseCollectionArgs("reduce", []interface{}{routine, list, int64(0)}, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, NewCollectionResult(routine, list, []interface{}{int64(0)}), c)
+
+	c, err = ParseCollectionArgs("map", []interface{}{routine[0], list}, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, routine, c.Routine)
+
+	_, err = ParseCollectionArgs("map", []interface{}{routine}, 0)
+	assert.ErrorIs(t, err, CollectionRequiresArgumentsError)
+
+	_, err = ParseCollectionArgs("map", []interface{}{routine, list, 1}, 0)
+	assert.ErrorIs(t, err, CollectionTooManyArgumentsError)
+
+	_, err = ParseCollectionArgs("map", []interface{}{"routine", list}, 0)
+	assert.ErrorIs(t, err, CollectionRoutineInvalidError)
+
+	_, err = ParseCollectionArgs("map", []interface{}{routine, "list"}, 0)
+	assert.ErrorIs(t, err, CollectionListInvalidError)
+	assert.Contains(t, err.Error(), "map: ")
+}
@@ -0,0 +1,48 @@
+package primitives
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var CompareIncomparableError = errors.New("values cannot be compared")
+
+// CompareOperands orders two operands of a comparison. Numbers and numeric
+// strings are compared by value, other strings are compared lexically, and
+// any other combination is an error.
+func CompareOperands(a, b interface{}) (int, error) {
+	x, errA := ToNumber(a)
+	y, errB := ToNumber(b)
+	if errA == nil && errB == nil {
+		// ToNumber only returns numbers that ToBigFloat accepts
+		fx, _ := ToBigFloat(x)
+		fy, _ := ToBigFloat(y)
+		return fx.Cmp(fy), nil
+	}
+	if s, ok := a.(string); ok {
+		if t, ok := b.(string); ok {
+			return strings.Compare(s, t), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: %v (%T) and %v (%T)", CompareIncomparableError, a, a, b, b)
+}
+
+// compareChain implements lt, gt, le and ge: the result is true if holds is
+// true for the comparison of every argument with the next one
+func compareChain(name string, args []interface{}, holds func(cmp int) bool, requiresErr error) (interface{}, error) {
+	if len(args) < 2 {
+		return nil, requiresErr
+	}
+	result := true
+	for i := 1; i < len(args); i++ {
+		cmp, err := CompareOperands(args[i-1], args[i])
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		if !holds(cmp) {
+			result = false
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,58 @@
+package primitives
+
+import (
+	"testing"
+
+	"github.com/hyperifyio/gnd/pkg/primitive_types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompareOperands(t *testing.T) {
+	for _, tc := range []struct {
+		a, b interface{}
+		want int
+	}{
+		{int64(1), 2.5, -1},
+		{uint64(1 << 63), int64(-1), 1},
+		{"10", "9", 1},
+		{"7", int64(7), 0},
+		{"apple", "banana", -1},
+	} {
+		got, err := CompareOperands(tc.a, tc.b)
+		assert.NoError(t, err, "%v %v", tc.a, tc.b)
+		assert.Equal(t, tc.want, got, "%v %v", tc.a, tc.b)
+	}
+
+	for _, tc := range [][2]interface{}{{"a", int64(1)}, {nil, nil}, {true, false}} {
+		_, err := CompareOperands(tc[0], tc[1])
+		assert.ErrorIs(t, err, CompareIncomparableError, "%v", tc)
+	}
+}
+
+func TestComparisons(t *testing.T) {
+	tests := []struct {
+		primitive primitive_types.Primitive
+		args      []interface{}
+		want      bool
+	}{
+		{&Lt{}, []interface{}{int64(1), int64(2), int64(3)}, true},
+		{&Lt{}, []interface{}{int64(1), int64(1)}, false},
+		{&Le{}, []interface{}{int64(1), int64(1), 1.5}, true},
+		{&Le{}, []interface{}{int64(2), int64(1)}, false},
+		{&Gt{}, []interface{}{"0.7", 0.5}, true},
+		{&Gt{}, []interface{}{int64(3), int64(1), int64(2)}, false},
+		{&Ge{}, []interface{}{"b", "b", "a"}, true},
+		{&Ge{}, []interface{}{int8(1), uint(2)}, false},
+	}
+
+	for _, tt := range tests {
+		got, err := tt.primitive.Execute(tt.args)
+		assert.NoError(t, err, "%s %v", tt.primitive.Name(), tt.args)
+		assert.Equal(t, tt.want, got, "%s %v", tt.primitive.Name(), tt.args)
+	}
+
+	_, err := (&Lt{}).Execute([]interface{}{int64(1)})
+	assert.ErrorIs(t, err, LtRequiresArgumentsError)
+	_, err = (&Gt{}).Execute([]interface{}{int64(1), "x"})
+	assert.ErrorIs(t, err, CompareIncomparableError)
+}
@@ -28,7 +28,10 @@ func TestCompilePrimitive(t *testing.T) {
 				{
 					Opcode:      "let",
 					Destination: parsers.NewPropertyRef("x"),
-					Arguments:   []interface{}{"42"},
+					Arguments:   []interface{}{int64(42)},
+					File:        "/gnd/compile",
+					Line:        1,
+					Column:      1,
 				},
 			},
 		},
@@ -95,7 +98,10 @@ func TestCompilePrimitive(t *testing.T) {
 				{
 					Opcode:      "let",
 					Destination: parsers.NewPropertyRef("x"),
-					Arguments:   []interface{}{"42"},
+					Arguments:   []interface{}{int64(42)},
+					File:        "/gnd/compile",
+					Line:        1,
+					Column:      1,
 				},
 				{
 					Opcode:      "let",
@@ -0,0 +1,36 @@
+package primitives
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/hyperifyio/gnd/pkg/primitive_services"
+	"github.com/hyperifyio/gnd/pkg/primitive_types"
+)
+
+var ContainsRequiresArgumentsError = errors.New("contains: requires a string and a search string")
+
+// Contains represents the contains primitive
+type Contains struct{}
+
+var _ primitive_types.Primitive = &Contains{}
+
+func (p *Contains) Name() string {
+	return "/gnd/contains"
+}
+
+// Execute returns true if the string contains the search string
+func (p *Contains) Execute(args []interface{}) (interface{}, error) {
+	if len(args) != 2 {
+		return nil, ContainsRequiresArgumentsError
+	}
+	strs, err := stringArgs("contains", args)
+	if err != nil {
+		return nil, err
+	}
+	return strings.Contains(strs[0], strs[1]), nil
+}
+
+func init() {
+	primitive_services.RegisterPrimitive(&Contains{})
+}
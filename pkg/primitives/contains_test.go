@@ -0,0 +1,22 @@
+package primitives
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContains(t *testing.T) {
+	got, err := (&Contains{}).Execute([]interface{}{"hello world", "o w"})
+	assert.NoError(t, err)
+	assert.Equal(t, true, got)
+
+	got, err = (&Contains{}).Execute([]interface{}{"hello", "x"})
+	assert.NoError(t, err)
+	assert.Equal(t, false, got)
+
+	_, err = (&Contains{}).Execute([]interface{}{[]interface{}{"a"}, "a"})
+	assert.ErrorIs(t, err, StringArgumentInvalidError)
+	_, err = (&Contains{}).Execute([]interface{}{"hello"})
+	assert.ErrorIs(t, err, ContainsRequiresArgumentsError)
+}
@@ -0,0 +1,40 @@
+package primitives
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/hyperifyio/gnd/pkg/primitive_services"
+	"github.com/hyperifyio/gnd/pkg/primitive_types"
+)
+
+var DeleteRequiresArgumentsError = errors.New("delete: requires a map and a path")
+
+// Delete represents the delete primitive
+type Delete struct{}
+
+var _ primitive_types.Primitive = &Delete{}
+
+func (p *Delete) Name() string {
+	return "/gnd/delete"
+}
+
+// Execute returns a copy of the first argument without the value at the
+// path. A path that does not exist is not an error.
+func (p *Delete) Execute(args []interface{}) (interface{}, error) {
+	if len(args) != 2 {
+		return nil, DeleteRequiresArgumentsError
+	}
+	if _, ok := args[0].(map[string]interface{}); !ok {
+		return nil, fmt.Errorf("delete: %w", MapInvalidError)
+	}
+	path, err := ParsePath(args[1])
+	if err != nil {
+		return nil, fmt.Errorf("delete: %w", err)
+	}
+	return DeletePath(args[0], path), nil
+}
+
+func init() {
+	primitive_services.RegisterPrimitive(&Delete{})
+}
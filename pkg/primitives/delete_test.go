@@ -0,0 +1,25 @@
+package primitives
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDelete(t *testing.T) {
+	m := map[string]interface{}{"a": int64(1), "b": int64(2)}
+
+	got, err := (&Delete{}).Execute([]interface{}{m, "a"})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"b": int64(2)}, got)
+	assert.Len(t, m, 2)
+
+	got, err = (&Delete{}).Execute([]interface{}{m, "missing"})
+	assert.NoError(t, err)
+	assert.Equal(t, m, got)
+
+	_, err = (&Delete{}).Execute([]interface{}{[]interface{}{}, "a"})
+	assert.ErrorIs(t, err, MapInvalidError)
+	_, err = (&Delete{}).Execute([]interface{}{m})
+	assert.ErrorIs(t, err, DeleteRequiresArgumentsError)
+}
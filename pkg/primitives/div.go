@@ -0,0 +1,46 @@
+package primitives
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/hyperifyio/gnd/pkg/primitive_services"
+	"github.com/hyperifyio/gnd/pkg/primitive_types"
+)
+
+var DivRequiresArgumentsError = errors.New("div: requires at least two arguments")
+
+// Div represents the div primitive
+type Div struct{}
+
+var _ primitive_types.Primitive = &Div{}
+
+func (p *Div) Name() string {
+	return "/gnd/div"
+}
+
+// Execute divides the first argument by the remaining ones. Integer division
+// truncates toward zero.
+func (p *Div) Execute(args []interface{}) (interface{}, error) {
+	if len(args) < 2 {
+		return nil, DivRequiresArgumentsError
+	}
+	return foldArithmetic("div", args, arithmeticOp{
+		ints: func(a, b *big.Int) (*big.Int, error) {
+			if b.Sign() == 0 {
+				return nil, ArithmeticDivisionByZeroError
+			}
+			return new(big.Int).Quo(a, b), nil
+		},
+		floats: func(a, b float64) (float64, error) {
+			if b == 0 {
+				return 0, ArithmeticDivisionByZeroError
+			}
+			return a / b, nil
+		},
+	})
+}
+
+func init() {
+	primitive_services.RegisterPrimitive(&Div{})
+}
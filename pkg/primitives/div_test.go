@@ -0,0 +1,38 @@
+package primitives
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiv(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []interface{}
+		want    interface{}
+		wantErr error
+	}{
+		{"integer truncates", []interface{}{int64(7), int64(2)}, int64(3), nil},
+		{"integer truncates toward zero", []interface{}{int64(-7), int64(2)}, int64(-3), nil},
+		{"fold", []interface{}{int64(100), int64(5), int64(2)}, int64(10), nil},
+		{"float", []interface{}{7.0, int64(2)}, 3.5, nil},
+		{"integer by zero", []interface{}{int64(1), int64(0)}, nil, ArithmeticDivisionByZeroError},
+		{"float by zero", []interface{}{1.0, 0.0}, nil, ArithmeticDivisionByZeroError},
+		{"min int64 by minus one", []interface{}{int64(math.MinInt64), int64(-1)}, nil, ArithmeticOverflowError},
+		{"one argument", []interface{}{int64(1)}, nil, DivRequiresArgumentsError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := (&Div{}).Execute(tt.args)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package primitives
+
+import (
+	"github.com/hyperifyio/gnd/pkg/parsers"
+	"github.com/hyperifyio/gnd/pkg/primitive_services"
+	"github.com/hyperifyio/gnd/pkg/primitive_types"
+)
+
+// Each represents the each primitive
+type Each struct{}
+
+var _ primitive_types.Primitive = &Each{}
+var _ primitive_types.BlockSuccessResultHandler = &Each{}
+
+func (p *Each) Name() string {
+	return "/gnd/each"
+}
+
+// Execute validates the arguments and returns a CollectionResult for the
+// interpreter to handle
+func (p *Each) Execute(args []interface{}) (interface{}, error) {
+	return ParseCollectionArgs("each", args, 0)
+}
+
+// HandleBlockSuccessResult runs the routine for each element, with "_" set
+// to the element, for its side effects. The result is the original list.
+func (p *Each) HandleBlockSuccessResult(result interface{}, i primitive_types.Interpreter, destination *parsers.PropertyRef, _ []*parsers.Instruction) (interface{}, error) {
+	c, ok := GetCollectionResult(result)
+	if !ok {
+		return result, nil
+	}
+
+	for _, item := range c.List {
+		if _, err := RunRoutine(i, p.Name(), c.Routine, item); err != nil {
+			return nil, err
+		}
+	}
+	return HandleRoutineResult(i, destination, c.List)
+}
+
+func init() {
+	primitive_services.RegisterPrimitive(&Each{})
+}
@@ -0,0 +1,22 @@
+package primitives_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEach(t *testing.T) {
+	slots := map[string]interface{}{
+		"upper":   parseRoutine(t, "uppercase _\n"),
+		"throwOn": parseRoutine(t, "$bad eq _ \"b\"\n$throw compile \"throw _\"\n$err when $bad $throw \"bad element\"\n"),
+		"list":    []interface{}{"a", "b"},
+	}
+
+	got, err := runBlock(t, slots, `$result each $upper $list`)
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{"a", "b"}, got)
+
+	_, err = runBlock(t, slots, `$result each $throwOn $list`)
+	assert.ErrorContains(t, err, "bad element")
+}
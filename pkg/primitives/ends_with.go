@@ -0,0 +1,36 @@
+package primitives
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/hyperifyio/gnd/pkg/primitive_services"
+	"github.com/hyperifyio/gnd/pkg/primitive_types"
+)
+
+var EndsWithRequiresArgumentsError = errors.New("ends-with: requires a string and a suffix")
+
+// EndsWith represents the ends-with primitive
+type EndsWith struct{}
+
+var _ primitive_types.Primitive = &EndsWith{}
+
+func (p *EndsWith) Name() string {
+	return "/gnd/ends-with"
+}
+
+// Execute returns true if the string ends with the suffix
+func (p *EndsWith) Execute(args []interface{}) (interface{}, error) {
+	if len(args) != 2 {
+		return nil, EndsWithRequiresArgumentsError
+	}
+	strs, err := stringArgs("ends-with", args)
+	if err != nil {
+		return nil, err
+	}
+	return strings.HasSuffix(strs[0], strs[1]), nil
+}
+
+func init() {
+	primitive_services.RegisterPrimitive(&EndsWith{})
+}
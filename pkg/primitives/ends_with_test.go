@@ -0,0 +1,20 @@
+package primitives
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEndsWith(t *testing.T) {
+	got, err := (&EndsWith{}).Execute([]interface{}{"report.md", ".md"})
+	assert.NoError(t, err)
+	assert.Equal(t, true, got)
+
+	got, err = (&EndsWith{}).Execute([]interface{}{"report.md", ".txt"})
+	assert.NoError(t, err)
+	assert.Equal(t, false, got)
+
+	_, err = (&EndsWith{}).Execute([]interface{}{"report.md"})
+	assert.ErrorIs(t, err, EndsWithRequiresArgumentsError)
+}
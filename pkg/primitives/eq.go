@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"github.com/hyperifyio/gnd/pkg/primitive_services"
 	"github.com/hyperifyio/gnd/pkg/primitive_types"
-	"reflect"
 )
 
 var EqRequiresAtLeastTwoArguments = fmt.Errorf("eq expects at least 2 arguments, got 0")
@@ -18,6 +17,8 @@ func (e *Eq) Name() string {
 	return "/gnd/eq"
 }
 
+// Execute returns true if all arguments are equal. Numbers are compared by
+// value, so `eq 1 1.0` is true.
 func (e *Eq) Execute(args []interface{}) (interface{}, error) {
 	if len(args) < 2 {
 		return nil, EqRequiresAtLeastTwoArguments
@@ -28,7 +29,7 @@ func (e *Eq) Execute(args []interface{}) (interface{}, error) {
 
 	// Compare all other values against the first value
 	for i := 1; i < len(args); i++ {
-		if !reflect.DeepEqual(firstValue, args[i]) {
+		if !ValuesEqual(firstValue, args[i]) {
 			return false, nil
 		}
 	}
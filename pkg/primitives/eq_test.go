@@ -61,6 +61,24 @@ func TestEq(t *testing.T) {
 			expected: false,
 			wantErr:  false,
 		},
+		{
+			name:     "integer and float literals",
+			args:     []interface{}{int64(1), 1.0},
+			expected: true,
+			wantErr:  false,
+		},
+		{
+			name:     "different numeric types",
+			args:     []interface{}{int64(2), uint8(2), float32(2)},
+			expected: true,
+			wantErr:  false,
+		},
+		{
+			name:     "integer and float with fraction",
+			args:     []interface{}{int64(1), 1.5},
+			expected: false,
+			wantErr:  false,
+		},
 		{
 			name:     "mixed type comparison",
 			args:     []interface{}{1, "1"},
@@ -0,0 +1,31 @@
+package primitives
+
+import (
+	"github.com/hyperifyio/gnd/pkg/primitive_services"
+	"github.com/hyperifyio/gnd/pkg/primitive_types"
+)
+
+// ErrorCause represents the error-cause primitive
+type ErrorCause struct{}
+
+var _ primitive_types.Primitive = &ErrorCause{}
+
+func (p *ErrorCause) Name() string {
+	return "/gnd/error-cause"
+}
+
+// Execute returns the error value that caused an error value, or nil
+func (p *ErrorCause) Execute(args []interface{}) (interface{}, error) {
+	value, err := errorValueArg("error-cause", args)
+	if err != nil {
+		return nil, err
+	}
+	if cause := value.CauseValue(); cause != nil {
+		return cause, nil
+	}
+	return nil, nil
+}
+
+func init() {
+	primitive_services.RegisterPrimitive(&ErrorCause{})
+}
@@ -0,0 +1,26 @@
+package primitives_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestErrorCause(t *testing.T) {
+	slots := map[string]interface{}{
+		"fetch": parseRoutine(t, "throw { code timeout message \"no reply\" }\n"),
+		"wrap":  parseRoutine(t, "$f compile \"throw { code timeout }\"\n$r try $f\n$e get $r 1\nthrow { code fetch-failed data \"/items\" cause $e }\n"),
+	}
+
+	got, err := runBlock(t, slots, "$r try $wrap\n$e get $r 1\n$c error-cause $e\n$result error-code $c\n")
+	assert.NoError(t, err)
+	assert.Equal(t, "timeout", got)
+
+	got, err = runBlock(t, slots, "$r try $wrap\n$e get $r 1\n$result error-data $e\n")
+	assert.NoError(t, err)
+	assert.Equal(t, "/items", got)
+
+	got, err = runBlock(t, slots, "$r try $fetch\n$e get $r 1\n$result error-cause $e\n")
+	assert.NoError(t, err)
+	assert.Nil(t, got)
+}
@@ -0,0 +1,28 @@
+package primitives
+
+import (
+	"github.com/hyperifyio/gnd/pkg/primitive_services"
+	"github.com/hyperifyio/gnd/pkg/primitive_types"
+)
+
+// ErrorCode represents the error-code primitive
+type ErrorCode struct{}
+
+var _ primitive_types.Primitive = &ErrorCode{}
+
+func (p *ErrorCode) Name() string {
+	return "/gnd/error-code"
+}
+
+// Execute returns the code of an error value, e.g. timeout
+func (p *ErrorCode) Execute(args []interface{}) (interface{}, error) {
+	value, err := errorValueArg("error-code", args)
+	if err != nil {
+		return nil, err
+	}
+	return value.Code, nil
+}
+
+func init() {
+	primitive_services.RegisterPrimitive(&ErrorCode{})
+}
@@ -0,0 +1,54 @@
+package primitives_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hyperifyio/gnd/pkg/primitives"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestErrorCode(t *testing.T) {
+	dir := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "validate.gnd"), []byte("throw { code invalid-input data { field name } }\n"), 0644))
+
+	slots := map[string]interface{}{
+		"throw":    parseRoutine(t, "throw { code invalid-input data _ }\n"),
+		"divide":   parseRoutine(t, "div 1 0\n"),
+		"nested":   parseRoutine(t, "$f compile \"throw { code not-found data _ }\"\nexec $f\n"),
+		"async":    parseRoutine(t, "$f compile \"throw { code rate-limited data _ }\"\n$task async $f\nawait $task\n"),
+		"unit":     parseRoutine(t, "validate\n"),
+		"rethrown": parseRoutine(t, "$f compile \"throw { code timeout }\"\n$r try $f\n$e get $r 1\nthrow $e\n"),
+	}
+	tests := []struct {
+		name    string
+		routine string
+		want    string
+	}{
+		{"thrown code", "throw", "invalid-input"},
+		{"primitive error", "divide", "error"},
+		{"through exec", "nested", "not-found"},
+		{"through async and await", "async", "rate-limited"},
+		{"through a subroutine call", "unit", "invalid-input"},
+		{"thrown again", "rethrown", "timeout"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := runBlockIn(t, dir, slots, "$r try $"+tt.routine+"\n$e get $r 1\n$result error-code $e\n")
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	_, err := runBlock(t, nil, `$result error-code "timeout"`)
+	assert.ErrorIs(t, err, primitives.ErrorValueInvalidError)
+}
+
+func TestRunTimeoutErrorCode(t *testing.T) {
+	allowRun(t, "sleep")
+	slots := map[string]interface{}{"slow": parseRoutine(t, "run sleep [\"5\"] { timeout 50 }\n")}
+	got, err := runBlockIn(t, t.TempDir(), slots, "$r try $slow\n$e get $r 1\n$result error-code $e\n")
+	assert.NoError(t, err)
+	assert.Equal(t, "timeout", got)
+}
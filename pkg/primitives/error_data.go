@@ -0,0 +1,28 @@
+package primitives
+
+import (
+	"github.com/hyperifyio/gnd/pkg/primitive_services"
+	"github.com/hyperifyio/gnd/pkg/primitive_types"
+)
+
+// ErrorData represents the error-data primitive
+type ErrorData struct{}
+
+var _ primitive_types.Primitive = &ErrorData{}
+
+func (p *ErrorData) Name() string {
+	return "/gnd/error-data"
+}
+
+// Execute returns the data given to throw with an error code, or nil
+func (p *ErrorData) Execute(args []interface{}) (interface{}, error) {
+	value, err := errorValueArg("error-data", args)
+	if err != nil {
+		return nil, err
+	}
+	return value.Data, nil
+}
+
+func init() {
+	primitive_services.RegisterPrimitive(&ErrorData{})
+}
@@ -0,0 +1,22 @@
+package primitives_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestErrorData(t *testing.T) {
+	slots := map[string]interface{}{
+		"details": parseRoutine(t, "throw { code invalid-input data { field name limit 3 } }\n"),
+		"message": parseRoutine(t, "throw \"something failed\"\n"),
+	}
+
+	got, err := runBlock(t, slots, "$r try $details\n$e get $r 1\n$result error-data $e\n")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"field": "name", "limit": int64(3)}, got)
+
+	got, err = runBlock(t, slots, "$r try $message\n$e get $r 1\n$result error-data $e\n")
+	assert.NoError(t, err)
+	assert.Nil(t, got)
+}
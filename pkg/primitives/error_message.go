@@ -0,0 +1,28 @@
+package primitives
+
+import (
+	"github.com/hyperifyio/gnd/pkg/primitive_services"
+	"github.com/hyperifyio/gnd/pkg/primitive_types"
+)
+
+// ErrorMessage represents the error-message primitive
+type ErrorMessage struct{}
+
+var _ primitive_types.Primitive = &ErrorMessage{}
+
+func (p *ErrorMessage) Name() string {
+	return "/gnd/error-message"
+}
+
+// Execute returns the message of an error value
+func (p *ErrorMessage) Execute(args []interface{}) (interface{}, error) {
+	value, err := errorValueArg("error-message", args)
+	if err != nil {
+		return nil, err
+	}
+	return value.Message, nil
+}
+
+func init() {
+	primitive_services.RegisterPrimitive(&ErrorMessage{})
+}
@@ -0,0 +1,18 @@
+package primitives_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestErrorMessage(t *testing.T) {
+	slots := map[string]interface{}{"fail": parseRoutine(t, "throw { code invalid-input message \"name is missing\" }\n")}
+
+	got, err := runBlock(t, slots, "$r try $fail\n$e get $r 1\n$result error-message $e\n")
+	assert.NoError(t, err)
+	assert.Equal(t, "name is missing", got)
+
+	_, err = runBlock(t, nil, `$result error-message 1 2`)
+	assert.Error(t, err)
+}
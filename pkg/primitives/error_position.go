@@ -0,0 +1,29 @@
+package primitives
+
+import (
+	"github.com/hyperifyio/gnd/pkg/primitive_services"
+	"github.com/hyperifyio/gnd/pkg/primitive_types"
+)
+
+// ErrorPosition represents the error-position primitive
+type ErrorPosition struct{}
+
+var _ primitive_types.Primitive = &ErrorPosition{}
+
+func (p *ErrorPosition) Name() string {
+	return "/gnd/error-position"
+}
+
+// Execute returns the position of the instruction that raised an error value as
+// "unit:line:column", or an empty string if it is not known
+func (p *ErrorPosition) Execute(args []interface{}) (interface{}, error) {
+	value, err := errorValueArg("error-position", args)
+	if err != nil {
+		return nil, err
+	}
+	return value.Position, nil
+}
+
+func init() {
+	primitive_services.RegisterPrimitive(&ErrorPosition{})
+}
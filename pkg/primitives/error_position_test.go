@@ -0,0 +1,15 @@
+package primitives_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestErrorPosition(t *testing.T) {
+	slots := map[string]interface{}{"fail": parseRoutine(t, "let 1\n  throw { code invalid-input }\n")}
+
+	got, err := runBlock(t, slots, "$r try $fail\n$e get $r 1\n$result error-position $e\n")
+	assert.NoError(t, err)
+	assert.Equal(t, "routine:2:3", got)
+}
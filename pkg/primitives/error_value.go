@@ -0,0 +1,23 @@
+package primitives
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/hyperifyio/gnd/pkg/runtime_errors"
+)
+
+var ErrorValueInvalidError = errors.New("argument must be an error value")
+
+// errorValueArg returns the single argument of the primitive name as an
+// ErrorValue
+func errorValueArg(name string, args []interface{}) (*runtime_errors.ErrorValue, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf("%s: requires exactly one error value", name)
+	}
+	value, ok := args[0].(*runtime_errors.ErrorValue)
+	if !ok {
+		return nil, fmt.Errorf("%s: %w: %v (%T)", name, ErrorValueInvalidError, args[0], args[0])
+	}
+	return value, nil
+}
@@ -2,6 +2,7 @@ package primitives
 
 import (
 	"errors"
+	"fmt"
 	"github.com/hyperifyio/gnd/pkg/loggers"
 	"github.com/hyperifyio/gnd/pkg/parsers"
 	"github.com/hyperifyio/gnd/pkg/primitive_services"
@@ -27,7 +28,6 @@ func (c *Exec) Name() string {
 // Execute runs the exec primitive
 func (c *Exec) Execute(args []interface{}) (interface{}, error) {
 
-	var routine []*parsers.Instruction
 	var routineArgs []interface{}
 
 	if len(args) == 0 {
@@ -35,17 +35,12 @@ func (c *Exec) Execute(args []interface{}) (interface{}, error) {
 	}
 
 	// Get the routine
-	switch v := args[0].(type) {
-	case []*parsers.Instruction:
-		routine = v
-		routineArgs = args[1:]
-	case *parsers.Instruction:
-		routine = []*parsers.Instruction{v}
-		routineArgs = args[1:]
-	default:
+	routine, ok := ToRoutine(args[0])
+	if !ok {
 		loggers.Printf(loggers.Error, "exec: routine must be an instruction array, got %T", args[0])
 		return nil, ExecRoutineInvalidError
 	}
+	routineArgs = args[1:]
 
 	// Return an ExecResult for the interpreter to handle
 	return NewExecResult(routine, routineArgs), nil
@@ -84,8 +79,8 @@ func HandleExecResult(i primitive_types.Interpreter, execResult *ExecResult) (in
 	// Execute the routine
 	result, err := interpreter.ExecuteInstructionBlock("/gnd/exec", args, routine)
 	if err != nil {
-		i.LogError("[/gnd/exec]: HandleExecResult: routine execution failed: %v", err)
-		return nil, ExecRoutineExecuteFailedError
+		i.LogDebug("[/gnd/exec]: HandleExecResult: routine execution failed: %v", err)
+		return nil, fmt.Errorf("%w: %w", ExecRoutineExecuteFailedError, err)
 	}
 
 	return result, nil
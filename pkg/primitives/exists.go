@@ -0,0 +1,55 @@
+package primitives
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+
+	"github.com/hyperifyio/gnd/pkg/parsers"
+	"github.com/hyperifyio/gnd/pkg/primitive_services"
+	"github.com/hyperifyio/gnd/pkg/primitive_types"
+)
+
+var ExistsRequiresPathError = errors.New("exists: requires exactly one path")
+
+// Exists represents the exists primitive
+type Exists struct{}
+
+var _ primitive_types.Primitive = &Exists{}
+var _ primitive_types.BlockSuccessResultHandler = &Exists{}
+
+func (p *Exists) Name() string {
+	return "/gnd/exists"
+}
+
+// Execute validates the arguments and returns a FileRequest for the
+// interpreter to handle
+func (p *Exists) Execute(args []interface{}) (interface{}, error) {
+	if len(args) != 1 {
+		return nil, ExistsRequiresPathError
+	}
+	return NewFileRequest("exists", args)
+}
+
+// HandleBlockSuccessResult returns true if a file or directory exists at the
+// path. A path outside the allowed directories is an error, not false.
+func (p *Exists) HandleBlockSuccessResult(result interface{}, i primitive_types.Interpreter, destination *parsers.PropertyRef, _ []*parsers.Instruction) (interface{}, error) {
+	request, ok := GetFileRequest(result)
+	if !ok {
+		return result, nil
+	}
+	path, err := ResolveFilePath(i.GetScriptDir(), request.Path)
+	if err != nil {
+		return nil, fmt.Errorf("exists: %w", err)
+	}
+	_, err = os.Stat(path)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("exists: %w", err)
+	}
+	return HandleRoutineResult(i, destination, err == nil)
+}
+
+func init() {
+	primitive_services.RegisterPrimitive(&Exists{})
+}
@@ -0,0 +1,26 @@
+package primitives_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hyperifyio/gnd/pkg/primitives"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExists(t *testing.T) {
+	dir := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "a.txt"), nil, 0644))
+
+	got, err := runBlockIn(t, dir, nil, `$result exists "a.txt"`)
+	assert.NoError(t, err)
+	assert.Equal(t, true, got)
+
+	got, err = runBlockIn(t, dir, nil, `$result exists "b.txt"`)
+	assert.NoError(t, err)
+	assert.Equal(t, false, got)
+
+	_, err = runBlockIn(t, dir, nil, `$result exists "/etc/passwd"`)
+	assert.ErrorIs(t, err, primitives.FileOutsideRootsError)
+}
@@ -1,6 +1,9 @@
 package primitives
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+)
 
 // ExitResult represents a result that signals the interpreter to exit
 type ExitResult struct {
@@ -22,8 +25,17 @@ func (e *ExitResult) Error() string {
 	return fmt.Sprintf("exit with code %d", e.Code)
 }
 
-// GetExitResult extracts the ExitResult from a value if it is one
+// GetExitResult extracts the ExitResult from a value if it is one.
+// Errors are unwrapped, so an exit propagated through nested routines is found.
 func GetExitResult(v interface{}) (*ExitResult, bool) {
-	result, ok := v.(*ExitResult)
-	return result, ok
+	if result, ok := v.(*ExitResult); ok {
+		return result, true
+	}
+	if err, ok := v.(error); ok {
+		var result *ExitResult
+		if errors.As(err, &result) {
+			return result, true
+		}
+	}
+	return nil, false
 }
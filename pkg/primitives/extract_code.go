@@ -0,0 +1,46 @@
+package primitives
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/hyperifyio/gnd/pkg/extractors"
+	"github.com/hyperifyio/gnd/pkg/primitive_services"
+	"github.com/hyperifyio/gnd/pkg/primitive_types"
+)
+
+var ExtractCodeRequiresArgumentsError = errors.New("extract-code: requires a string and an optional language")
+
+// ExtractCode represents the extract-code primitive
+type ExtractCode struct{}
+
+var _ primitive_types.Primitive = &ExtractCode{}
+
+func (p *ExtractCode) Name() string {
+	return "/gnd/extract-code"
+}
+
+// Execute returns the code of the first fenced block, or of the first block
+// of the given language. A text without fenced blocks is returned trimmed.
+func (p *ExtractCode) Execute(args []interface{}) (interface{}, error) {
+	if len(args) < 1 || len(args) > 2 {
+		return nil, ExtractCodeRequiresArgumentsError
+	}
+	strs, err := stringArgs("extract-code", args)
+	if err != nil {
+		return nil, err
+	}
+	lang := ""
+	if len(strs) == 2 {
+		lang = strs[1]
+	}
+	code, err := extractors.ExtractCode(strs[0], lang)
+	if err != nil {
+		return nil, fmt.Errorf("extract-code: %w", err)
+	}
+	return code, nil
+}
+
+func init() {
+	primitive_services.RegisterPrimitive(&ExtractCode{})
+}
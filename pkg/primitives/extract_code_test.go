@@ -0,0 +1,27 @@
+package primitives
+
+import (
+	"testing"
+
+	"github.com/hyperifyio/gnd/pkg/extractors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExtractCode(t *testing.T) {
+	reply := "Here you go:\n```gnd\n$a trim\nlowercase $a\n```\n```sh\nls\n```\nEnjoy!"
+
+	got, err := (&ExtractCode{}).Execute([]interface{}{reply})
+	assert.NoError(t, err)
+	assert.Equal(t, "$a trim\nlowercase $a", got)
+
+	got, err = (&ExtractCode{}).Execute([]interface{}{reply, "sh"})
+	assert.NoError(t, err)
+	assert.Equal(t, "ls", got)
+
+	_, err = (&ExtractCode{}).Execute([]interface{}{reply, "python"})
+	assert.ErrorIs(t, err, extractors.CodeNotFoundError)
+	_, err = (&ExtractCode{}).Execute([]interface{}{reply, int64(1)})
+	assert.ErrorIs(t, err, StringArgumentInvalidError)
+	_, err = (&ExtractCode{}).Execute([]interface{}{})
+	assert.ErrorIs(t, err, ExtractCodeRequiresArgumentsError)
+}
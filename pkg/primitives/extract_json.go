@@ -0,0 +1,46 @@
+package primitives
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/hyperifyio/gnd/pkg/extractors"
+	"github.com/hyperifyio/gnd/pkg/primitive_services"
+	"github.com/hyperifyio/gnd/pkg/primitive_types"
+)
+
+var ExtractJsonRequiresStringError = errors.New("extract-json: requires exactly one string")
+
+// ExtractJson represents the extract-json primitive
+type ExtractJson struct{}
+
+var _ primitive_types.Primitive = &ExtractJson{}
+
+func (p *ExtractJson) Name() string {
+	return "/gnd/extract-json"
+}
+
+// Execute finds the first JSON object or array in fenced blocks or prose
+// and decodes it like json-parse
+func (p *ExtractJson) Execute(args []interface{}) (interface{}, error) {
+	if len(args) != 1 {
+		return nil, ExtractJsonRequiresStringError
+	}
+	text, err := stringArg("extract-json", args, 0)
+	if err != nil {
+		return nil, err
+	}
+	raw, err := extractors.ExtractJSON(text)
+	if err != nil {
+		return nil, fmt.Errorf("extract-json: %w", err)
+	}
+	value, err := DecodeJSON(raw)
+	if err != nil {
+		return nil, fmt.Errorf("extract-json: %w", err)
+	}
+	return value, nil
+}
+
+func init() {
+	primitive_services.RegisterPrimitive(&ExtractJson{})
+}
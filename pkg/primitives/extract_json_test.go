@@ -0,0 +1,23 @@
+package primitives
+
+import (
+	"testing"
+
+	"github.com/hyperifyio/gnd/pkg/extractors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExtractJson(t *testing.T) {
+	got, err := (&ExtractJson{}).Execute([]interface{}{"Result:\n```json\n{\"score\": 8, \"tags\": [\"a\"]}\n```"})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"score": int64(8), "tags": []interface{}{"a"}}, got)
+
+	got, err = (&ExtractJson{}).Execute([]interface{}{"The values are [1.5, 2] I think."})
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{1.5, int64(2)}, got)
+
+	_, err = (&ExtractJson{}).Execute([]interface{}{"I cannot answer that."})
+	assert.ErrorIs(t, err, extractors.JsonNotFoundError)
+	_, err = (&ExtractJson{}).Execute([]interface{}{"a", "b"})
+	assert.ErrorIs(t, err, ExtractJsonRequiresStringError)
+}
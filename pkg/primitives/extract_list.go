@@ -0,0 +1,41 @@
+package primitives
+
+import (
+	"errors"
+
+	"github.com/hyperifyio/gnd/pkg/extractors"
+	"github.com/hyperifyio/gnd/pkg/primitive_services"
+	"github.com/hyperifyio/gnd/pkg/primitive_types"
+)
+
+var ExtractListRequiresStringError = errors.New("extract-list: requires exactly one string")
+
+// ExtractList represents the extract-list primitive
+type ExtractList struct{}
+
+var _ primitive_types.Primitive = &ExtractList{}
+
+func (p *ExtractList) Name() string {
+	return "/gnd/extract-list"
+}
+
+// Execute returns the items of the first bullet or numbered list as an array
+// of strings, which is empty if the text has no list
+func (p *ExtractList) Execute(args []interface{}) (interface{}, error) {
+	if len(args) != 1 {
+		return nil, ExtractListRequiresStringError
+	}
+	text, err := stringArg("extract-list", args, 0)
+	if err != nil {
+		return nil, err
+	}
+	result := []interface{}{}
+	for _, item := range extractors.ExtractList(text) {
+		result = append(result, item)
+	}
+	return result, nil
+}
+
+func init() {
+	primitive_services.RegisterPrimitive(&ExtractList{})
+}
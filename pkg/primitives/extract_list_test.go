@@ -0,0 +1,22 @@
+package primitives
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExtractList(t *testing.T) {
+	got, err := (&ExtractList{}).Execute([]interface{}{"Steps:\n1. plan\n2. build\n\nDone."})
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{"plan", "build"}, got)
+
+	got, err = (&ExtractList{}).Execute([]interface{}{"No items."})
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{}, got)
+
+	_, err = (&ExtractList{}).Execute([]interface{}{int64(1)})
+	assert.ErrorIs(t, err, StringArgumentInvalidError)
+	_, err = (&ExtractList{}).Execute([]interface{}{"a", "b"})
+	assert.ErrorIs(t, err, ExtractListRequiresStringError)
+}
@@ -0,0 +1,130 @@
+package primitives
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// FileAccessEnabled enables the file primitives read-file, write-file,
+// list-dir and exists. When false, every file primitive fails.
+var FileAccessEnabled = true
+
+// FileRoots are the directories the file primitives may access. When empty,
+// a unit may access its own script directory and everything below it.
+var FileRoots []string
+
+var (
+	FileAccessDisabledError = errors.New("file access is disabled")
+	FileOutsideRootsError   = errors.New("path is outside the allowed directories")
+	FilePathInvalidError    = errors.New("path must be a non-empty string")
+	FileBrokenLinkError     = errors.New("path contains a broken symbolic link")
+)
+
+// FileRequest is returned by the Execute method of a file primitive. The
+// file operation is done by its HandleBlockSuccessResult, which knows the
+// script directory that relative paths are resolved against.
+type FileRequest struct {
+	// Path is the path as given in the script
+	Path string
+	// Args are the remaining arguments of the primitive
+	Args []interface{}
+}
+
+// String returns a string representation of the FileRequest
+func (r *FileRequest) String() string {
+	return fmt.Sprintf("FileRequest{path: %q, args: %v}", r.Path, r.Args)
+}
+
+// NewFileRequest validates the path argument of a file primitive
+func NewFileRequest(name string, args []interface{}) (*FileRequest, error) {
+	if !FileAccessEnabled {
+		return nil, fmt.Errorf("%s: %w", name, FileAccessDisabledError)
+	}
+	path, ok := args[0].(string)
+	if !ok || path == "" {
+		return nil, fmt.Errorf("%s: %w", name, FilePathInvalidError)
+	}
+	return &FileRequest{Path: path, Args: args[1:]}, nil
+}
+
+// GetFileRequest extracts the FileRequest from a value if it is one
+func GetFileRequest(v interface{}) (*FileRequest, bool) {
+	result, ok := v.(*FileRequest)
+	return result, ok
+}
+
+// ResolveFilePath resolves path relative to scriptDir and returns it with
+// all symbolic links resolved. The path must be inside one of FileRoots, or
+// inside scriptDir if no roots are configured. Paths that do not exist yet
+// are resolved through their closest existing parent directory, so a link
+// cannot be used to create a file outside the roots either.
+func ResolveFilePath(scriptDir, path string) (string, error) {
+	if !FileAccessEnabled {
+		return "", FileAccessDisabledError
+	}
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(scriptDir, path)
+	}
+	resolved, err := resolveSymlinks(path)
+	if err != nil {
+		return "", err
+	}
+
+	roots := FileRoots
+	if len(roots) == 0 {
+		roots = []string{scriptDir}
+	}
+	for _, root := range roots {
+		resolvedRoot, err := resolveSymlinks(root)
+		if err != nil {
+			return "", err
+		}
+		if isInsideDir(resolvedRoot, resolved) {
+			return resolved, nil
+		}
+	}
+	return "", fmt.Errorf("%w: %s", FileOutsideRootsError, path)
+}
+
+// resolveSymlinks returns the absolute form of path with symbolic links
+// resolved in its longest existing prefix
+func resolveSymlinks(path string) (string, error) {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return "", err
+	}
+	dir, rest := abs, ""
+	for {
+		real, err := filepath.EvalSymlinks(dir)
+		if err == nil {
+			return filepath.Join(real, rest), nil
+		}
+		if !errors.Is(err, fs.ErrNotExist) {
+			return "", err
+		}
+		// A link to a missing target could be followed when writing
+		if _, err := os.Lstat(dir); err == nil {
+			return "", fmt.Errorf("%w: %s", FileBrokenLinkError, dir)
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return abs, nil
+		}
+		rest = filepath.Join(filepath.Base(dir), rest)
+		dir = parent
+	}
+}
+
+// isInsideDir returns true if path is dir or below it. Both must be clean
+// absolute paths.
+func isInsideDir(dir, path string) bool {
+	rel, err := filepath.Rel(dir, path)
+	if err != nil {
+		return false
+	}
+	return rel != ".." && !strings.HasPrefix(rel, ".."+string(os.PathSeparator))
+}
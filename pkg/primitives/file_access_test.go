@@ -0,0 +1,71 @@
+package primitives
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResolveFilePath(t *testing.T) {
+	root := t.TempDir()
+	outside := t.TempDir()
+	assert.NoError(t, os.MkdirAll(filepath.Join(root, "docs"), 0755))
+	assert.NoError(t, os.WriteFile(filepath.Join(outside, "secret.txt"), []byte("secret"), 0644))
+	assert.NoError(t, os.Symlink(outside, filepath.Join(root, "escape")))
+	assert.NoError(t, os.Symlink(filepath.Join(outside, "missing.txt"), filepath.Join(root, "dangling")))
+
+	realRoot, err := filepath.EvalSymlinks(root)
+	assert.NoError(t, err)
+
+	got, err := ResolveFilePath(root, "docs/a.txt")
+	assert.NoError(t, err)
+	assert.Equal(t, filepath.Join(realRoot, "docs", "a.txt"), got)
+
+	got, err = ResolveFilePath(root, "docs/../b.txt")
+	assert.NoError(t, err)
+	assert.Equal(t, filepath.Join(realRoot, "b.txt"), got)
+
+	_, err = ResolveFilePath(filepath.Join(root, "docs"), "../b.txt")
+	assert.ErrorIs(t, err, FileOutsideRootsError)
+
+	_, err = ResolveFilePath(root, filepath.Join(outside, "secret.txt"))
+	assert.ErrorIs(t, err, FileOutsideRootsError)
+
+	_, err = ResolveFilePath(root, "escape/secret.txt")
+	assert.ErrorIs(t, err, FileOutsideRootsError)
+
+	_, err = ResolveFilePath(root, "escape/new/file.txt")
+	assert.ErrorIs(t, err, FileOutsideRootsError)
+
+	_, err = ResolveFilePath(root, "dangling")
+	assert.ErrorIs(t, err, FileBrokenLinkError)
+}
+
+func TestResolveFilePathRoots(t *testing.T) {
+	root := t.TempDir()
+	other := t.TempDir()
+	defer func(roots []string) { FileRoots = roots }(FileRoots)
+
+	FileRoots = []string{root, other}
+	_, err := ResolveFilePath(root, filepath.Join(other, "a.txt"))
+	assert.NoError(t, err)
+	_, err = ResolveFilePath(filepath.Join(root, "docs"), "../a.txt")
+	assert.NoError(t, err)
+
+	FileRoots = []string{other}
+	_, err = ResolveFilePath(root, "a.txt")
+	assert.ErrorIs(t, err, FileOutsideRootsError)
+}
+
+func TestResolveFilePathDisabled(t *testing.T) {
+	defer func() { FileAccessEnabled = true }()
+	FileAccessEnabled = false
+
+	_, err := ResolveFilePath(t.TempDir(), "a.txt")
+	assert.ErrorIs(t, err, FileAccessDisabledError)
+
+	_, err = (&ReadFile{}).Execute([]interface{}{"a.txt"})
+	assert.ErrorIs(t, err, FileAccessDisabledError)
+}
@@ -0,0 +1,48 @@
+package primitives
+
+import (
+	"github.com/hyperifyio/gnd/pkg/parsers"
+	"github.com/hyperifyio/gnd/pkg/primitive_services"
+	"github.com/hyperifyio/gnd/pkg/primitive_types"
+)
+
+// Filter represents the filter primitive
+type Filter struct{}
+
+var _ primitive_types.Primitive = &Filter{}
+var _ primitive_types.BlockSuccessResultHandler = &Filter{}
+
+func (p *Filter) Name() string {
+	return "/gnd/filter"
+}
+
+// Execute validates the arguments and returns a CollectionResult for the
+// interpreter to handle
+func (p *Filter) Execute(args []interface{}) (interface{}, error) {
+	return ParseCollectionArgs("filter", args, 0)
+}
+
+// HandleBlockSuccessResult runs the routine for each element, with "_" set
+// to the element, and keeps the elements for which the result is truthy
+func (p *Filter) HandleBlockSuccessResult(result interface{}, i primitive_types.Interpreter, destination *parsers.PropertyRef, _ []*parsers.Instruction) (interface{}, error) {
+	c, ok := GetCollectionResult(result)
+	if !ok {
+		return result, nil
+	}
+
+	filtered := make([]interface{}, 0, len(c.List))
+	for _, item := range c.List {
+		keep, err := RunRoutine(i, p.Name(), c.Routine, item)
+		if err != nil {
+			return nil, err
+		}
+		if Truthy(keep) {
+			filtered = append(filtered, item)
+		}
+	}
+	return HandleRoutineResult(i, destination, filtered)
+}
+
+func init() {
+	primitive_services.RegisterPrimitive(&Filter{})
+}
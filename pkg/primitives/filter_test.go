@@ -0,0 +1,18 @@
+package primitives_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilter(t *testing.T) {
+	slots := map[string]interface{}{
+		"notB": parseRoutine(t, "$b eq _ \"b\"\nselect $b 0 1\n"),
+		"list": []interface{}{"a", "b", "c", "b"},
+	}
+
+	got, err := runBlock(t, slots, `$result filter $notB $list`)
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{"a", "c"}, got)
+}
@@ -0,0 +1,50 @@
+package primitives
+
+import (
+	"github.com/hyperifyio/gnd/pkg/parsers"
+	"github.com/hyperifyio/gnd/pkg/primitive_services"
+	"github.com/hyperifyio/gnd/pkg/primitive_types"
+)
+
+// Find represents the find primitive
+type Find struct{}
+
+var _ primitive_types.Primitive = &Find{}
+var _ primitive_types.BlockSuccessResultHandler = &Find{}
+
+func (p *Find) Name() string {
+	return "/gnd/find"
+}
+
+// Execute validates the arguments and returns a CollectionResult for the
+// interpreter to handle
+func (p *Find) Execute(args []interface{}) (interface{}, error) {
+	return ParseCollectionArgs("find", args, 0)
+}
+
+// HandleBlockSuccessResult runs the routine for each element, with "_" set
+// to the element, and returns the first element for which the result is
+// truthy, or nil if there is none. Later elements are not visited.
+func (p *Find) HandleBlockSuccessResult(result interface{}, i primitive_types.Interpreter, destination *parsers.PropertyRef, _ []*parsers.Instruction) (interface{}, error) {
+	c, ok := GetCollectionResult(result)
+	if !ok {
+		return result, nil
+	}
+
+	var found interface{}
+	for _, item := range c.List {
+		match, err := RunRoutine(i, p.Name(), c.Routine, item)
+		if err != nil {
+			return nil, err
+		}
+		if Truthy(match) {
+			found = item
+			break
+		}
+	}
+	return HandleRoutineResult(i, destination, found)
+}
+
+func init() {
+	primitive_services.RegisterPrimitive(&Find{})
+}
@@ -0,0 +1,30 @@
+package primitives_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFind(t *testing.T) {
+	slots := map[string]interface{}{
+		"isB":  parseRoutine(t, "eq _ \"b\"\n"),
+		"list": []interface{}{"a", "b", "c"},
+	}
+
+	got, err := runBlock(t, slots, `$result find $isB $list`)
+	assert.NoError(t, err)
+	assert.Equal(t, "b", got)
+
+	got, err = runBlock(t, slots, `$result find $isB ["x" "y"]`)
+	assert.NoError(t, err)
+	assert.Nil(t, got)
+
+	// Elements after the match are not visited
+	slots["failOnC"] = parseRoutine(t, "$c eq _ \"c\"\n$throw compile \"throw _\"\n$fail when $c $throw \"visited c\"\neq _ \"b\"\n")
+	got, err = runBlock(t, slots, `$result find $failOnC $list`)
+	assert.NoError(t, err)
+	assert.Equal(t, "b", got)
+	_, err = runBlock(t, slots, `$result find $failOnC ["c"]`)
+	assert.ErrorContains(t, err, "visited c")
+}
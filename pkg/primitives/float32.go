@@ -61,6 +61,11 @@ func (f *Float32Type) Execute(args []interface{}) (interface{}, error) {
 		return float32(value), nil
 	}
 
+	// Handle int64
+	if value, ok := arg.(int64); ok {
+		return float32(value), nil
+	}
+
 	// Handle string
 	if str, ok := arg.(string); ok {
 		value, err := strconv.ParseFloat(str, 32)
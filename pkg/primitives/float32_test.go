@@ -28,6 +28,12 @@ func TestFloat32_Execute(t *testing.T) {
 			want:    42.0,
 			wantErr: false,
 		},
+		{
+			name:    "decimal int64",
+			arg:     int64(42),
+			want:    42.0,
+			wantErr: false,
+		},
 		{
 			name:    "zero",
 			arg:     0,
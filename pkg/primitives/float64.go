@@ -55,6 +55,11 @@ func (f *Float64Type) Execute(args []interface{}) (interface{}, error) {
 		return float64(value), nil
 	}
 
+	// Handle int64
+	if value, ok := arg.(int64); ok {
+		return float64(value), nil
+	}
+
 	// Handle string
 	if str, ok := arg.(string); ok {
 		value, err := strconv.ParseFloat(str, 64)
@@ -31,6 +31,13 @@ func TestFloat64_Execute(t *testing.T) {
 			delta:   1e-9,
 			wantErr: false,
 		},
+		{
+			name:    "decimal int64",
+			arg:     int64(42),
+			want:    42.0,
+			delta:   1e-9,
+			wantErr: false,
+		},
 		{
 			name:    "zero",
 			arg:     0,
@@ -0,0 +1,28 @@
+package primitives
+
+import (
+	"errors"
+
+	"github.com/hyperifyio/gnd/pkg/primitive_services"
+	"github.com/hyperifyio/gnd/pkg/primitive_types"
+)
+
+var GeRequiresArgumentsError = errors.New("ge: requires at least two arguments")
+
+// Ge represents the ge primitive
+type Ge struct{}
+
+var _ primitive_types.Primitive = &Ge{}
+
+func (p *Ge) Name() string {
+	return "/gnd/ge"
+}
+
+// Execute returns true if every argument is greater than or equal to the next one
+func (p *Ge) Execute(args []interface{}) (interface{}, error) {
+	return compareChain("ge", args, func(cmp int) bool { return cmp >= 0 }, GeRequiresArgumentsError)
+}
+
+func init() {
+	primitive_services.RegisterPrimitive(&Ge{})
+}
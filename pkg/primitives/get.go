@@ -0,0 +1,43 @@
+package primitives
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/hyperifyio/gnd/pkg/primitive_services"
+	"github.com/hyperifyio/gnd/pkg/primitive_types"
+)
+
+var GetRequiresArgumentsError = errors.New("get: requires a value, a path and an optional default")
+
+// Get represents the get primitive
+type Get struct{}
+
+var _ primitive_types.Primitive = &Get{}
+
+func (p *Get) Name() string {
+	return "/gnd/get"
+}
+
+// Execute returns the value at the path inside the first argument. If the
+// path does not exist, the default is returned when one is given.
+func (p *Get) Execute(args []interface{}) (interface{}, error) {
+	if len(args) < 2 || len(args) > 3 {
+		return nil, GetRequiresArgumentsError
+	}
+	path, err := ParsePath(args[1])
+	if err != nil {
+		return nil, fmt.Errorf("get: %w", err)
+	}
+	if value, ok := LookupPath(args[0], path); ok {
+		return value, nil
+	}
+	if len(args) == 3 {
+		return args[2], nil
+	}
+	return nil, fmt.Errorf("get: %w: %v", MapPathNotFoundError, args[1])
+}
+
+func init() {
+	primitive_services.RegisterPrimitive(&Get{})
+}
@@ -0,0 +1,34 @@
+package primitives_test
+
+import (
+	"testing"
+
+	"github.com/hyperifyio/gnd/pkg/primitives"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGet(t *testing.T) {
+	slots := map[string]interface{}{}
+
+	got, err := runBlock(t, slots, "$m let { user { name ada tags [x y] } }\n$result get $m [user name]\n")
+	assert.NoError(t, err)
+	assert.Equal(t, "ada", got)
+
+	got, err = runBlock(t, slots, "$m let { user { name ada tags [x y] } }\n$result get $m [user tags 1]\n")
+	assert.NoError(t, err)
+	assert.Equal(t, "y", got)
+
+	got, err = runBlock(t, slots, "$m let { user { name ada } }\n$result get $m \"user\"\n")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"name": "ada"}, got)
+
+	got, err = runBlock(t, slots, "$m let { a 1 }\n$result get $m b \"none\"\n")
+	assert.NoError(t, err)
+	assert.Equal(t, "none", got)
+
+	_, err = runBlock(t, slots, "$m let { a 1 }\n$result get $m b\n")
+	assert.ErrorIs(t, err, primitives.MapPathNotFoundError)
+
+	_, err = (&primitives.Get{}).Execute([]interface{}{map[string]interface{}{}})
+	assert.ErrorIs(t, err, primitives.GetRequiresArgumentsError)
+}
@@ -0,0 +1,28 @@
+package primitives
+
+import (
+	"errors"
+
+	"github.com/hyperifyio/gnd/pkg/primitive_services"
+	"github.com/hyperifyio/gnd/pkg/primitive_types"
+)
+
+var GtRequiresArgumentsError = errors.New("gt: requires at least two arguments")
+
+// Gt represents the gt primitive
+type Gt struct{}
+
+var _ primitive_types.Primitive = &Gt{}
+
+func (p *Gt) Name() string {
+	return "/gnd/gt"
+}
+
+// Execute returns true if every argument is greater than the next one
+func (p *Gt) Execute(args []interface{}) (interface{}, error) {
+	return compareChain("gt", args, func(cmp int) bool { return cmp > 0 }, GtRequiresArgumentsError)
+}
+
+func init() {
+	primitive_services.RegisterPrimitive(&Gt{})
+}
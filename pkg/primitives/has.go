@@ -0,0 +1,37 @@
+package primitives
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/hyperifyio/gnd/pkg/primitive_services"
+	"github.com/hyperifyio/gnd/pkg/primitive_types"
+)
+
+var HasRequiresArgumentsError = errors.New("has: requires a value and a path")
+
+// Has represents the has primitive
+type Has struct{}
+
+var _ primitive_types.Primitive = &Has{}
+
+func (p *Has) Name() string {
+	return "/gnd/has"
+}
+
+// Execute returns true if the path exists inside the first argument
+func (p *Has) Execute(args []interface{}) (interface{}, error) {
+	if len(args) != 2 {
+		return nil, HasRequiresArgumentsError
+	}
+	path, err := ParsePath(args[1])
+	if err != nil {
+		return nil, fmt.Errorf("has: %w", err)
+	}
+	_, ok := LookupPath(args[0], path)
+	return ok, nil
+}
+
+func init() {
+	primitive_services.RegisterPrimitive(&Has{})
+}
@@ -0,0 +1,33 @@
+package primitives
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHas(t *testing.T) {
+	m := map[string]interface{}{
+		"user": map[string]interface{}{"name": nil},
+		"tags": []interface{}{"a"},
+	}
+
+	for _, tc := range []struct {
+		path interface{}
+		want bool
+	}{
+		{"user", true},
+		{[]interface{}{"user", "name"}, true},
+		{[]interface{}{"tags", int64(0)}, true},
+		{[]interface{}{"tags", int64(1)}, false},
+		{[]interface{}{"user", "age"}, false},
+		{"missing", false},
+	} {
+		got, err := (&Has{}).Execute([]interface{}{m, tc.path})
+		assert.NoError(t, err, "%v", tc.path)
+		assert.Equal(t, tc.want, got, "%v", tc.path)
+	}
+
+	_, err := (&Has{}).Execute([]interface{}{m})
+	assert.ErrorIs(t, err, HasRequiresArgumentsError)
+}
@@ -0,0 +1,45 @@
+package primitives_test
+
+import (
+	"testing"
+
+	"github.com/hyperifyio/gnd/pkg/interpreters"
+	"github.com/hyperifyio/gnd/pkg/parsers"
+	"github.com/hyperifyio/gnd/pkg/primitive_services"
+	"github.com/stretchr/testify/assert"
+)
+
+// runBlock parses and executes source in a new interpreter and returns the
+// value of the slot named result
+func runBlock(t *testing.T, slots map[string]interface{}, source string) (interface{}, error) {
+	return runBlockIn(t, "test", slots, source)
+}
+
+// runBlockIn is runBlock with the script directory set to dir
+func runBlockIn(t *testing.T, dir string, slots map[string]interface{}, source string) (interface{}, error) {
+	instructions, err := parsers.ParseInstructionLines("test.gnd", source)
+	assert.NoError(t, err)
+
+	i := interpreters.NewInterpreter(dir, primitive_services.GetDefaultOpcodeMap())
+	for name, value := range slots {
+		assert.NoError(t, i.SetSlot(name, value))
+	}
+	if _, err := i.ExecuteInstructionBlock("test.gnd", nil, instructions); err != nil {
+		return nil, err
+	}
+	return i.GetSlot("result")
+}
+
+// parseRoutine parses the source of a routine for use in a test
+func parseRoutine(t *testing.T, source string) []*parsers.Instruction {
+	routine, err := parsers.ParseInstructionLines("routine", source)
+	assert.NoError(t, err)
+	return routine
+}
+
+// concatRoutine returns a routine that concatenates the elements of its input
+func concatRoutine() []*parsers.Instruction {
+	return []*parsers.Instruction{
+		parsers.NewInstruction("/gnd/concat", parsers.NewPropertyRef("_"), []interface{}{parsers.NewSpreadPropertyRef("_")}),
+	}
+}
@@ -0,0 +1,42 @@
+package primitives
+
+import (
+	"errors"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/hyperifyio/gnd/pkg/primitive_services"
+	"github.com/hyperifyio/gnd/pkg/primitive_types"
+)
+
+var IndexOfRequiresArgumentsError = errors.New("index-of: requires a string and a search string")
+
+// IndexOf represents the index-of primitive
+type IndexOf struct{}
+
+var _ primitive_types.Primitive = &IndexOf{}
+
+func (p *IndexOf) Name() string {
+	return "/gnd/index-of"
+}
+
+// Execute returns the character index of the first occurrence of the search
+// string as an int64, or -1 if it does not occur
+func (p *IndexOf) Execute(args []interface{}) (interface{}, error) {
+	if len(args) != 2 {
+		return nil, IndexOfRequiresArgumentsError
+	}
+	strs, err := stringArgs("index-of", args)
+	if err != nil {
+		return nil, err
+	}
+	index := strings.Index(strs[0], strs[1])
+	if index < 0 {
+		return int64(-1), nil
+	}
+	return int64(utf8.RuneCountInString(strs[0][:index])), nil
+}
+
+func init() {
+	primitive_services.RegisterPrimitive(&IndexOf{})
+}
@@ -0,0 +1,24 @@
+package primitives
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIndexOf(t *testing.T) {
+	got, err := (&IndexOf{}).Execute([]interface{}{"hello", "l"})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), got)
+
+	got, err = (&IndexOf{}).Execute([]interface{}{"ääb", "b"})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), got)
+
+	got, err = (&IndexOf{}).Execute([]interface{}{"hello", "x"})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(-1), got)
+
+	_, err = (&IndexOf{}).Execute([]interface{}{"hello"})
+	assert.ErrorIs(t, err, IndexOfRequiresArgumentsError)
+}
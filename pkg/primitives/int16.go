@@ -42,6 +42,14 @@ func (i *Int16Type) Execute(args []interface{}) (interface{}, error) {
 		return int16(value), nil
 	}
 
+	// Handle int64
+	if value, ok := arg.(int64); ok {
+		if value < math.MinInt16 || value > math.MaxInt16 {
+			return nil, fmt.Errorf("int16 overflow: value %d outside range -32768..32767", value)
+		}
+		return int16(value), nil
+	}
+
 	// Handle float64
 	if value, ok := arg.(float64); ok {
 		if value != float64(int64(value)) {
@@ -28,6 +28,12 @@ func TestInt16_Execute(t *testing.T) {
 			want:    12345,
 			wantErr: false,
 		},
+		{
+			name:    "decimal int64",
+			arg:     int64(12345),
+			want:    12345,
+			wantErr: false,
+		},
 		{
 			name:    "zero",
 			arg:     0,
@@ -41,6 +41,11 @@ func (i *Int8Type) Execute(args []interface{}) (interface{}, error) {
 			return nil, Int8OverflowError
 		}
 		return int8(v), nil
+	case int64:
+		if v < -128 || v > 127 {
+			return nil, Int8OverflowError
+		}
+		return int8(v), nil
 	case float32:
 		if float32(int8(v)) != v {
 			return nil, Int8FractionalError
@@ -27,6 +27,12 @@ func TestInt8_Execute(t *testing.T) {
 			want:    42,
 			wantErr: false,
 		},
+		{
+			name:    "decimal int64",
+			arg:     int64(42),
+			want:    42,
+			wantErr: false,
+		},
 		{
 			name:    "zero",
 			arg:     0,
@@ -0,0 +1,58 @@
+package primitives
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hyperifyio/gnd/pkg/primitive_services"
+	"github.com/hyperifyio/gnd/pkg/primitive_types"
+)
+
+var (
+	JoinRequiresArgumentsError = errors.New("join: requires an array and an optional separator")
+	JoinListInvalidError       = errors.New("join: first argument must be an array")
+	JoinElementInvalidError    = errors.New("join: elements must be strings or numbers")
+)
+
+// Join represents the join primitive
+type Join struct{}
+
+var _ primitive_types.Primitive = &Join{}
+
+func (p *Join) Name() string {
+	return "/gnd/join"
+}
+
+// Execute joins the elements of an array with a separator, which defaults
+// to the empty string. Numbers and booleans are formatted like concat does.
+func (p *Join) Execute(args []interface{}) (interface{}, error) {
+	if len(args) < 1 || len(args) > 2 {
+		return nil, JoinRequiresArgumentsError
+	}
+	list, ok := args[0].([]interface{})
+	if !ok {
+		return nil, JoinListInvalidError
+	}
+	separator := ""
+	if len(args) == 2 {
+		var err error
+		if separator, err = stringArg("join", args, 1); err != nil {
+			return nil, err
+		}
+	}
+
+	parts := make([]string, len(list))
+	for i, item := range list {
+		switch item.(type) {
+		case []interface{}, map[string]interface{}, nil:
+			return nil, fmt.Errorf("%w: %v (%T)", JoinElementInvalidError, item, item)
+		}
+		parts[i] = fmt.Sprintf("%v", item)
+	}
+	return strings.Join(parts, separator), nil
+}
+
+func init() {
+	primitive_services.RegisterPrimitive(&Join{})
+}
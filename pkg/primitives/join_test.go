@@ -0,0 +1,30 @@
+package primitives
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJoin(t *testing.T) {
+	got, err := (&Join{}).Execute([]interface{}{[]interface{}{"a", "b", "c"}, ", "})
+	assert.NoError(t, err)
+	assert.Equal(t, "a, b, c", got)
+
+	got, err = (&Join{}).Execute([]interface{}{[]interface{}{"a", int64(1), 2.5, true}})
+	assert.NoError(t, err)
+	assert.Equal(t, "a12.5true", got)
+
+	got, err = (&Join{}).Execute([]interface{}{[]interface{}{}, ","})
+	assert.NoError(t, err)
+	assert.Equal(t, "", got)
+
+	_, err = (&Join{}).Execute([]interface{}{[]interface{}{[]interface{}{"a"}}})
+	assert.ErrorIs(t, err, JoinElementInvalidError)
+	_, err = (&Join{}).Execute([]interface{}{"abc"})
+	assert.ErrorIs(t, err, JoinListInvalidError)
+	_, err = (&Join{}).Execute([]interface{}{[]interface{}{}, int64(1)})
+	assert.ErrorIs(t, err, StringArgumentInvalidError)
+	_, err = (&Join{}).Execute([]interface{}{})
+	assert.ErrorIs(t, err, JoinRequiresArgumentsError)
+}
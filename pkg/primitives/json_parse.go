@@ -0,0 +1,41 @@
+package primitives
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/hyperifyio/gnd/pkg/primitive_services"
+	"github.com/hyperifyio/gnd/pkg/primitive_types"
+)
+
+var JsonParseRequiresStringError = errors.New("json-parse: requires exactly one string")
+
+// JsonParse represents the json-parse primitive
+type JsonParse struct{}
+
+var _ primitive_types.Primitive = &JsonParse{}
+
+func (p *JsonParse) Name() string {
+	return "/gnd/json-parse"
+}
+
+// Execute decodes a JSON document into maps, arrays, strings, numbers,
+// bools and nil
+func (p *JsonParse) Execute(args []interface{}) (interface{}, error) {
+	if len(args) != 1 {
+		return nil, JsonParseRequiresStringError
+	}
+	text, ok := args[0].(string)
+	if !ok {
+		return nil, JsonParseRequiresStringError
+	}
+	value, err := DecodeJSON(text)
+	if err != nil {
+		return nil, fmt.Errorf("json-parse: %w", err)
+	}
+	return value, nil
+}
+
+func init() {
+	primitive_services.RegisterPrimitive(&JsonParse{})
+}
@@ -0,0 +1,24 @@
+package primitives
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJsonParse(t *testing.T) {
+	got, err := (&JsonParse{}).Execute([]interface{}{`{"answer": "yes", "confidence": 0.9}`})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"answer": "yes", "confidence": 0.9}, got)
+
+	got, err = (&JsonParse{}).Execute([]interface{}{`[1, "two"]`})
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{int64(1), "two"}, got)
+
+	_, err = (&JsonParse{}).Execute([]interface{}{"Sure! Here is the JSON"})
+	assert.ErrorIs(t, err, JsonInvalidError)
+	_, err = (&JsonParse{}).Execute([]interface{}{int64(1)})
+	assert.ErrorIs(t, err, JsonParseRequiresStringError)
+	_, err = (&JsonParse{}).Execute([]interface{}{})
+	assert.ErrorIs(t, err, JsonParseRequiresStringError)
+}
@@ -0,0 +1,38 @@
+package primitives
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/hyperifyio/gnd/pkg/primitive_services"
+	"github.com/hyperifyio/gnd/pkg/primitive_types"
+)
+
+var JsonStringifyRequiresArgumentsError = errors.New("json-stringify: requires a value and an optional pretty flag")
+
+// JsonStringify represents the json-stringify primitive
+type JsonStringify struct{}
+
+var _ primitive_types.Primitive = &JsonStringify{}
+
+func (p *JsonStringify) Name() string {
+	return "/gnd/json-stringify"
+}
+
+// Execute encodes the value as JSON with map keys in sorted order. The
+// output is indented if the optional second argument is truthy.
+func (p *JsonStringify) Execute(args []interface{}) (interface{}, error) {
+	if len(args) < 1 || len(args) > 2 {
+		return nil, JsonStringifyRequiresArgumentsError
+	}
+	pretty := len(args) == 2 && Truthy(args[1])
+	text, err := EncodeJSON(args[0], pretty)
+	if err != nil {
+		return nil, fmt.Errorf("json-stringify: %w", err)
+	}
+	return text, nil
+}
+
+func init() {
+	primitive_services.RegisterPrimitive(&JsonStringify{})
+}
@@ -0,0 +1,28 @@
+package primitives
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJsonStringify(t *testing.T) {
+	value := map[string]interface{}{"z": int64(1), "a": []interface{}{"x"}}
+
+	got, err := (&JsonStringify{}).Execute([]interface{}{value})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"a":["x"],"z":1}`, got)
+
+	got, err = (&JsonStringify{}).Execute([]interface{}{value, true})
+	assert.NoError(t, err)
+	assert.Equal(t, "{\n  \"a\": [\n    \"x\"\n  ],\n  \"z\": 1\n}", got)
+
+	got, err = (&JsonStringify{}).Execute([]interface{}{"text", int64(0)})
+	assert.NoError(t, err)
+	assert.Equal(t, `"text"`, got)
+
+	_, err = (&JsonStringify{}).Execute([]interface{}{})
+	assert.ErrorIs(t, err, JsonStringifyRequiresArgumentsError)
+	_, err = (&JsonStringify{}).Execute([]interface{}{struct{}{}})
+	assert.ErrorIs(t, err, JsonUnsupportedValueError)
+}
@@ -0,0 +1,120 @@
+package primitives
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+var (
+	JsonInvalidError          = errors.New("invalid JSON")
+	JsonUnsupportedValueError = errors.New("value cannot be represented in JSON")
+)
+
+// DecodeJSON decodes a single JSON document into interpreter values: maps,
+// arrays, strings, bools, nil, and numbers as int64 if they are integers
+// that fit, and as float64 otherwise
+func DecodeJSON(text string) (interface{}, error) {
+	decoder := json.NewDecoder(strings.NewReader(text))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return nil, fmt.Errorf("%w: %v", JsonInvalidError, err)
+	}
+	if _, err := decoder.Token(); err != io.EOF {
+		return nil, fmt.Errorf("%w: unexpected data after the value", JsonInvalidError)
+	}
+	return fromJSONValue(value)
+}
+
+// fromJSONValue replaces json.Number values with int64 or float64
+func fromJSONValue(v interface{}) (interface{}, error) {
+	switch value := v.(type) {
+	case json.Number:
+		if i, err := strconv.ParseInt(string(value), 10, 64); err == nil {
+			return i, nil
+		}
+		f, err := strconv.ParseFloat(string(value), 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w: number out of range: %s", JsonInvalidError, value)
+		}
+		return f, nil
+	case []interface{}:
+		for i, item := range value {
+			converted, err := fromJSONValue(item)
+			if err != nil {
+				return nil, err
+			}
+			value[i] = converted
+		}
+		return value, nil
+	case map[string]interface{}:
+		for key, item := range value {
+			converted, err := fromJSONValue(item)
+			if err != nil {
+				return nil, err
+			}
+			value[key] = converted
+		}
+		return value, nil
+	default:
+		return value, nil
+	}
+}
+
+// EncodeJSON encodes a value as JSON. Map keys are sorted and HTML
+// characters are not escaped. If indent is true, the output is indented with
+// two spaces.
+func EncodeJSON(value interface{}, indent bool) (string, error) {
+	if err := checkJSONValue(value); err != nil {
+		return "", err
+	}
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if indent {
+		encoder.SetIndent("", "  ")
+	}
+	if err := encoder.Encode(value); err != nil {
+		return "", fmt.Errorf("%w: %v", JsonUnsupportedValueError, err)
+	}
+	return strings.TrimSuffix(buf.String(), "\n"), nil
+}
+
+// checkJSONValue returns an error if value contains anything other than
+// maps, arrays, strings, bools, nil and finite numbers
+func checkJSONValue(v interface{}) error {
+	switch value := v.(type) {
+	case nil, string, bool:
+		return nil
+	case []interface{}:
+		for _, item := range value {
+			if err := checkJSONValue(item); err != nil {
+				return err
+			}
+		}
+		return nil
+	case map[string]interface{}:
+		for _, item := range value {
+			if err := checkJSONValue(item); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	switch reflect.ValueOf(v).Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return nil
+	case reflect.Float32, reflect.Float64:
+		if _, ok := ToBigFloat(v); ok {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: %v (%T)", JsonUnsupportedValueError, v, v)
+}
@@ -0,0 +1,49 @@
+package primitives
+
+import (
+	"math"
+	"testing"
+
+	"github.com/hyperifyio/gnd/pkg/parsers"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeJSON(t *testing.T) {
+	got, err := DecodeJSON(` {"name": "Ada", "age": 36, "score": 0.5, "big": 1e3, "ok": true, "none": null, "tags": ["a", 1]} `)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"name":  "Ada",
+		"age":   int64(36),
+		"score": 0.5,
+		"big":   1000.0,
+		"ok":    true,
+		"none":  nil,
+		"tags":  []interface{}{"a", int64(1)},
+	}, got)
+
+	got, err = DecodeJSON("18446744073709551616")
+	assert.NoError(t, err)
+	assert.Equal(t, 18446744073709551616.0, got)
+
+	for _, text := range []string{"", "{", "[1,]", "1 2", "{} x", "1e999"} {
+		_, err := DecodeJSON(text)
+		assert.ErrorIs(t, err, JsonInvalidError, text)
+	}
+}
+
+func TestEncodeJSON(t *testing.T) {
+	value := map[string]interface{}{"b": []interface{}{int64(1), 2.5, nil}, "a": "<x>", "c": uint8(3)}
+
+	got, err := EncodeJSON(value, false)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"a":"<x>","b":[1,2.5,null],"c":3}`, got)
+
+	got, err = EncodeJSON(map[string]interface{}{"a": true}, true)
+	assert.NoError(t, err)
+	assert.Equal(t, "{\n  \"a\": true\n}", got)
+
+	for _, v := range []interface{}{math.NaN(), []interface{}{parsers.NewPropertyRef("x")}, map[string]interface{}{"f": func() {}}} {
+		_, err := EncodeJSON(v, false)
+		assert.ErrorIs(t, err, JsonUnsupportedValueError)
+	}
+}
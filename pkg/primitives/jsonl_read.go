@@ -0,0 +1,51 @@
+package primitives
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hyperifyio/gnd/pkg/primitive_services"
+	"github.com/hyperifyio/gnd/pkg/primitive_types"
+)
+
+var JsonlReadRequiresStringError = errors.New("jsonl-read: requires exactly one string")
+
+// JsonlRead represents the jsonl-read primitive
+type JsonlRead struct{}
+
+var _ primitive_types.Primitive = &JsonlRead{}
+
+func (p *JsonlRead) Name() string {
+	return "/gnd/jsonl-read"
+}
+
+// Execute decodes JSON Lines text, one JSON document per line, into an
+// array of records. Blank lines are skipped.
+func (p *JsonlRead) Execute(args []interface{}) (interface{}, error) {
+	if len(args) != 1 {
+		return nil, JsonlReadRequiresStringError
+	}
+	text, ok := args[0].(string)
+	if !ok {
+		return nil, JsonlReadRequiresStringError
+	}
+
+	records := []interface{}{}
+	for idx, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		record, err := DecodeJSON(line)
+		if err != nil {
+			return nil, fmt.Errorf("jsonl-read: line %d: %w", idx+1, err)
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+func init() {
+	primitive_services.RegisterPrimitive(&JsonlRead{})
+}
@@ -0,0 +1,26 @@
+package primitives
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJsonlRead(t *testing.T) {
+	got, err := (&JsonlRead{}).Execute([]interface{}{"{\"id\": 1}\n\n{\"id\": 2}\r\n"})
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"id": int64(1)},
+		map[string]interface{}{"id": int64(2)},
+	}, got)
+
+	got, err = (&JsonlRead{}).Execute([]interface{}{""})
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{}, got)
+
+	_, err = (&JsonlRead{}).Execute([]interface{}{"{\"id\": 1}\n{\"id\":"})
+	assert.ErrorIs(t, err, JsonInvalidError)
+	assert.ErrorContains(t, err, "line 2")
+	_, err = (&JsonlRead{}).Execute([]interface{}{[]interface{}{}})
+	assert.ErrorIs(t, err, JsonlReadRequiresStringError)
+}
@@ -0,0 +1,48 @@
+package primitives
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hyperifyio/gnd/pkg/primitive_services"
+	"github.com/hyperifyio/gnd/pkg/primitive_types"
+)
+
+var JsonlWriteRequiresArrayError = errors.New("jsonl-write: requires exactly one array of records")
+
+// JsonlWrite represents the jsonl-write primitive
+type JsonlWrite struct{}
+
+var _ primitive_types.Primitive = &JsonlWrite{}
+
+func (p *JsonlWrite) Name() string {
+	return "/gnd/jsonl-write"
+}
+
+// Execute encodes an array of records as JSON Lines text: each record on its
+// own line, with a newline after the last one
+func (p *JsonlWrite) Execute(args []interface{}) (interface{}, error) {
+	if len(args) != 1 {
+		return nil, JsonlWriteRequiresArrayError
+	}
+	records, ok := args[0].([]interface{})
+	if !ok {
+		return nil, JsonlWriteRequiresArrayError
+	}
+
+	var b strings.Builder
+	for idx, record := range records {
+		line, err := EncodeJSON(record, false)
+		if err != nil {
+			return nil, fmt.Errorf("jsonl-write: record %d: %w", idx+1, err)
+		}
+		b.WriteString(line)
+		b.WriteByte('\n')
+	}
+	return b.String(), nil
+}
+
+func init() {
+	primitive_services.RegisterPrimitive(&JsonlWrite{})
+}
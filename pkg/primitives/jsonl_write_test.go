@@ -0,0 +1,25 @@
+package primitives
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJsonlWrite(t *testing.T) {
+	got, err := (&JsonlWrite{}).Execute([]interface{}{[]interface{}{
+		map[string]interface{}{"id": int64(1), "text": "a\nb"},
+		"plain",
+	}})
+	assert.NoError(t, err)
+	assert.Equal(t, "{\"id\":1,\"text\":\"a\\nb\"}\n\"plain\"\n", got)
+
+	got, err = (&JsonlWrite{}).Execute([]interface{}{[]interface{}{}})
+	assert.NoError(t, err)
+	assert.Equal(t, "", got)
+
+	_, err = (&JsonlWrite{}).Execute([]interface{}{[]interface{}{struct{}{}}})
+	assert.ErrorIs(t, err, JsonUnsupportedValueError)
+	_, err = (&JsonlWrite{}).Execute([]interface{}{map[string]interface{}{}})
+	assert.ErrorIs(t, err, JsonlWriteRequiresArrayError)
+}
@@ -0,0 +1,41 @@
+package primitives
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/hyperifyio/gnd/pkg/primitive_services"
+	"github.com/hyperifyio/gnd/pkg/primitive_types"
+)
+
+var KeysRequiresArgumentError = errors.New("keys: requires exactly one map")
+
+// Keys represents the keys primitive
+type Keys struct{}
+
+var _ primitive_types.Primitive = &Keys{}
+
+func (p *Keys) Name() string {
+	return "/gnd/keys"
+}
+
+// Execute returns the keys of a map as an array in ascending order
+func (p *Keys) Execute(args []interface{}) (interface{}, error) {
+	if len(args) != 1 {
+		return nil, KeysRequiresArgumentError
+	}
+	m, ok := args[0].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("keys: %w", MapInvalidError)
+	}
+	keys := SortedKeys(m)
+	result := make([]interface{}, len(keys))
+	for i, key := range keys {
+		result[i] = key
+	}
+	return result, nil
+}
+
+func init() {
+	primitive_services.RegisterPrimitive(&Keys{})
+}
@@ -0,0 +1,22 @@
+package primitives
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeys(t *testing.T) {
+	got, err := (&Keys{}).Execute([]interface{}{map[string]interface{}{"b": int64(1), "a": int64(2)}})
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{"a", "b"}, got)
+
+	got, err = (&Keys{}).Execute([]interface{}{map[string]interface{}{}})
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{}, got)
+
+	_, err = (&Keys{}).Execute([]interface{}{"a"})
+	assert.ErrorIs(t, err, MapInvalidError)
+	_, err = (&Keys{}).Execute([]interface{}{})
+	assert.ErrorIs(t, err, KeysRequiresArgumentError)
+}
@@ -0,0 +1,28 @@
+package primitives
+
+import (
+	"errors"
+
+	"github.com/hyperifyio/gnd/pkg/primitive_services"
+	"github.com/hyperifyio/gnd/pkg/primitive_types"
+)
+
+var LeRequiresArgumentsError = errors.New("le: requires at least two arguments")
+
+// Le represents the le primitive
+type Le struct{}
+
+var _ primitive_types.Primitive = &Le{}
+
+func (p *Le) Name() string {
+	return "/gnd/le"
+}
+
+// Execute returns true if every argument is less than or equal to the next one
+func (p *Le) Execute(args []interface{}) (interface{}, error) {
+	return compareChain("le", args, func(cmp int) bool { return cmp <= 0 }, LeRequiresArgumentsError)
+}
+
+func init() {
+	primitive_services.RegisterPrimitive(&Le{})
+}
@@ -0,0 +1,46 @@
+package primitives
+
+import (
+	"errors"
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/hyperifyio/gnd/pkg/primitive_services"
+	"github.com/hyperifyio/gnd/pkg/primitive_types"
+)
+
+var (
+	LengthRequiresArgumentError = errors.New("length: requires exactly one argument")
+	LengthArgumentInvalidError  = errors.New("length: argument must be a string, an array or a map")
+)
+
+// Length represents the length primitive
+type Length struct{}
+
+var _ primitive_types.Primitive = &Length{}
+
+func (p *Length) Name() string {
+	return "/gnd/length"
+}
+
+// Execute returns the number of characters in a string, elements in an
+// array or keys in a map as an int64
+func (p *Length) Execute(args []interface{}) (interface{}, error) {
+	if len(args) != 1 {
+		return nil, LengthRequiresArgumentError
+	}
+	switch v := args[0].(type) {
+	case string:
+		return int64(utf8.RuneCountInString(v)), nil
+	case []interface{}:
+		return int64(len(v)), nil
+	case map[string]interface{}:
+		return int64(len(v)), nil
+	default:
+		return nil, fmt.Errorf("%w: %v (%T)", LengthArgumentInvalidError, v, v)
+	}
+}
+
+func init() {
+	primitive_services.RegisterPrimitive(&Length{})
+}
@@ -0,0 +1,33 @@
+package primitives
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLength(t *testing.T) {
+	tests := []struct {
+		name string
+		arg  interface{}
+		want int64
+	}{
+		{"string", "hello", 5},
+		{"characters not bytes", "häällö", 6},
+		{"empty string", "", 0},
+		{"array", []interface{}{"a", int64(1)}, 2},
+		{"map", map[string]interface{}{"a": int64(1)}, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := (&Length{}).Execute([]interface{}{tt.arg})
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	_, err := (&Length{}).Execute([]interface{}{int64(5)})
+	assert.ErrorIs(t, err, LengthArgumentInvalidError)
+	_, err = (&Length{}).Execute([]interface{}{"a", "b"})
+	assert.ErrorIs(t, err, LengthRequiresArgumentError)
+}
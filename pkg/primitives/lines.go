@@ -0,0 +1,44 @@
+package primitives
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/hyperifyio/gnd/pkg/primitive_services"
+	"github.com/hyperifyio/gnd/pkg/primitive_types"
+)
+
+var LinesRequiresStringError = errors.New("lines: requires exactly one string")
+
+// Lines represents the lines primitive
+type Lines struct{}
+
+var _ primitive_types.Primitive = &Lines{}
+
+func (p *Lines) Name() string {
+	return "/gnd/lines"
+}
+
+// Execute splits a string into lines. Both "\n" and "\r\n" end a line, and
+// a final line ending does not start another line.
+func (p *Lines) Execute(args []interface{}) (interface{}, error) {
+	if len(args) != 1 {
+		return nil, LinesRequiresStringError
+	}
+	s, err := stringArg("lines", args, 0)
+	if err != nil {
+		return nil, err
+	}
+	result := []interface{}{}
+	if s == "" {
+		return result, nil
+	}
+	for _, line := range strings.Split(strings.TrimSuffix(s, "\n"), "\n") {
+		result = append(result, strings.TrimSuffix(line, "\r"))
+	}
+	return result, nil
+}
+
+func init() {
+	primitive_services.RegisterPrimitive(&Lines{})
+}
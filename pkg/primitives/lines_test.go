@@ -0,0 +1,34 @@
+package primitives
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLines(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []interface{}
+	}{
+		{"empty", "", []interface{}{}},
+		{"single line", "a", []interface{}{"a"}},
+		{"final newline", "a\nb\n", []interface{}{"a", "b"}},
+		{"windows line endings", "a\r\nb\r\n", []interface{}{"a", "b"}},
+		{"empty lines", "a\n\nb", []interface{}{"a", "", "b"}},
+		{"only a newline", "\n", []interface{}{""}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := (&Lines{}).Execute([]interface{}{tt.input})
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	_, err := (&Lines{}).Execute([]interface{}{int64(1)})
+	assert.ErrorIs(t, err, StringArgumentInvalidError)
+	_, err = (&Lines{}).Execute([]interface{}{"a", "b"})
+	assert.ErrorIs(t, err, LinesRequiresStringError)
+}
@@ -0,0 +1,63 @@
+package primitives
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/hyperifyio/gnd/pkg/parsers"
+	"github.com/hyperifyio/gnd/pkg/primitive_services"
+	"github.com/hyperifyio/gnd/pkg/primitive_types"
+)
+
+var ListDirRequiresPathError = errors.New("list-dir: requires exactly one path")
+
+// ListDir represents the list-dir primitive
+type ListDir struct{}
+
+var _ primitive_types.Primitive = &ListDir{}
+var _ primitive_types.BlockSuccessResultHandler = &ListDir{}
+
+func (p *ListDir) Name() string {
+	return "/gnd/list-dir"
+}
+
+// Execute validates the arguments and returns a FileRequest for the
+// interpreter to handle
+func (p *ListDir) Execute(args []interface{}) (interface{}, error) {
+	if len(args) != 1 {
+		return nil, ListDirRequiresPathError
+	}
+	return NewFileRequest("list-dir", args)
+}
+
+// HandleBlockSuccessResult returns the names of the directory entries in
+// sorted order. Names of directories end with a slash.
+func (p *ListDir) HandleBlockSuccessResult(result interface{}, i primitive_types.Interpreter, destination *parsers.PropertyRef, _ []*parsers.Instruction) (interface{}, error) {
+	request, ok := GetFileRequest(result)
+	if !ok {
+		return result, nil
+	}
+	path, err := ResolveFilePath(i.GetScriptDir(), request.Path)
+	if err != nil {
+		return nil, fmt.Errorf("list-dir: %w", err)
+	}
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return nil, fmt.Errorf("list-dir: %w", err)
+	}
+
+	names := make([]interface{}, len(entries))
+	for idx, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() {
+			name += "/"
+		}
+		names[idx] = name
+	}
+	return HandleRoutineResult(i, destination, names)
+}
+
+func init() {
+	primitive_services.RegisterPrimitive(&ListDir{})
+}
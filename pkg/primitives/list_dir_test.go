@@ -0,0 +1,31 @@
+package primitives_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hyperifyio/gnd/pkg/primitives"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListDir(t *testing.T) {
+	dir := t.TempDir()
+	assert.NoError(t, os.MkdirAll(filepath.Join(dir, "sub"), 0755))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "b.txt"), nil, 0644))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "a.txt"), nil, 0644))
+
+	got, err := runBlockIn(t, dir, nil, `$result list-dir "."`)
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{"a.txt", "b.txt", "sub/"}, got)
+
+	got, err = runBlockIn(t, dir, nil, `$result list-dir "sub"`)
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{}, got)
+
+	_, err = runBlockIn(t, filepath.Join(dir, "sub"), nil, `$result list-dir ".."`)
+	assert.ErrorIs(t, err, primitives.FileOutsideRootsError)
+
+	_, err = runBlockIn(t, dir, nil, `$result list-dir`)
+	assert.Error(t, err)
+}
@@ -0,0 +1,82 @@
+package primitives
+
+import (
+	"fmt"
+
+	"github.com/hyperifyio/gnd/pkg/parsers"
+	"github.com/hyperifyio/gnd/pkg/primitive_types"
+)
+
+// LogicResult represents a short-circuiting and or or whose operands include
+// routines that the interpreter must run
+type LogicResult struct {
+	// Operands are the values and routines in argument order
+	Operands []interface{}
+	// StopOn is the truth value that ends the evaluation: false for and, true
+	// for or. It is also the result if an operand has that value.
+	StopOn bool
+}
+
+// String returns a string representation of the LogicResult
+func (r *LogicResult) String() string {
+	return fmt.Sprintf("LogicResult{operands: %v, stopOn: %v}", r.Operands, r.StopOn)
+}
+
+// NewLogicResult creates a new LogicResult
+func NewLogicResult(operands []interface{}, stopOn bool) *LogicResult {
+	return &LogicResult{
+		Operands: operands,
+		StopOn:   stopOn,
+	}
+}
+
+// GetLogicResult extracts the LogicResult from a value if it is one
+func GetLogicResult(v interface{}) (*LogicResult, bool) {
+	result, ok := v.(*LogicResult)
+	return result, ok
+}
+
+// executeLogic implements and and or. Without routine operands the result is
+// computed directly; otherwise a LogicResult is returned for the interpreter.
+func executeLogic(args []interface{}, stopOn bool, requiresErr error) (interface{}, error) {
+	if len(args) < 2 {
+		return nil, requiresErr
+	}
+	for _, arg := range args {
+		if _, ok := ToRoutine(arg); ok {
+			return NewLogicResult(args, stopOn), nil
+		}
+	}
+	for _, arg := range args {
+		if Truthy(arg) == stopOn {
+			return stopOn, nil
+		}
+	}
+	return !stopOn, nil
+}
+
+// handleLogicResult evaluates the operands of a LogicResult from left to
+// right, running routines with an empty input, and stops at the first
+// operand whose truth value is StopOn. Later routines are not run.
+func handleLogicResult(name string, result interface{}, i primitive_types.Interpreter, destination *parsers.PropertyRef) (interface{}, error) {
+	logicResult, ok := GetLogicResult(result)
+	if !ok {
+		return HandleRoutineResult(i, destination, result)
+	}
+
+	value := !logicResult.StopOn
+	for _, operand := range logicResult.Operands {
+		if routine, ok := ToRoutine(operand); ok {
+			res, err := RunRoutine(i, name, routine, []interface{}{})
+			if err != nil {
+				return nil, err
+			}
+			operand = res
+		}
+		if Truthy(operand) == logicResult.StopOn {
+			value = logicResult.StopOn
+			break
+		}
+	}
+	return HandleRoutineResult(i, destination, value)
+}
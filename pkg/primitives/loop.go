@@ -0,0 +1,20 @@
+package primitives
+
+import (
+	"errors"
+	"fmt"
+)
+
+// MaxLoopIterations limits the number of iterations of range, repeat and
+// while. Zero or a negative value disables the limit.
+var MaxLoopIterations = 10000
+
+var LoopMaxIterationsError = errors.New("loop: iteration limit exceeded")
+
+// checkLoopIterations returns an error if n iterations exceed MaxLoopIterations
+func checkLoopIterations(name string, n int) error {
+	if MaxLoopIterations > 0 && n > MaxLoopIterations {
+		return fmt.Errorf("%s: %w: %d > %d", name, LoopMaxIterationsError, n, MaxLoopIterations)
+	}
+	return nil
+}
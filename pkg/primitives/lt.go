@@ -0,0 +1,28 @@
+package primitives
+
+import (
+	"errors"
+
+	"github.com/hyperifyio/gnd/pkg/primitive_services"
+	"github.com/hyperifyio/gnd/pkg/primitive_types"
+)
+
+var LtRequiresArgumentsError = errors.New("lt: requires at least two arguments")
+
+// Lt represents the lt primitive
+type Lt struct{}
+
+var _ primitive_types.Primitive = &Lt{}
+
+func (p *Lt) Name() string {
+	return "/gnd/lt"
+}
+
+// Execute returns true if every argument is less than the next one
+func (p *Lt) Execute(args []interface{}) (interface{}, error) {
+	return compareChain("lt", args, func(cmp int) bool { return cmp < 0 }, LtRequiresArgumentsError)
+}
+
+func init() {
+	primitive_services.RegisterPrimitive(&Lt{})
+}
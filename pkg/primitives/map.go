@@ -0,0 +1,46 @@
+package primitives
+
+import (
+	"github.com/hyperifyio/gnd/pkg/parsers"
+	"github.com/hyperifyio/gnd/pkg/primitive_services"
+	"github.com/hyperifyio/gnd/pkg/primitive_types"
+)
+
+// Map represents the map primitive
+type Map struct{}
+
+var _ primitive_types.Primitive = &Map{}
+var _ primitive_types.BlockSuccessResultHandler = &Map{}
+
+func (p *Map) Name() string {
+	return "/gnd/map"
+}
+
+// Execute validates the arguments and returns a CollectionResult for the
+// interpreter to handle
+func (p *Map) Execute(args []interface{}) (interface{}, error) {
+	return ParseCollectionArgs("map", args, 0)
+}
+
+// HandleBlockSuccessResult runs the routine for each element, with "_" set
+// to the element, and collects the results into a new array
+func (p *Map) HandleBlockSuccessResult(result interface{}, i primitive_types.Interpreter, destination *parsers.PropertyRef, _ []*parsers.Instruction) (interface{}, error) {
+	c, ok := GetCollectionResult(result)
+	if !ok {
+		return result, nil
+	}
+
+	mapped := make([]interface{}, 0, len(c.List))
+	for _, item := range c.List {
+		res, err := RunRoutine(i, p.Name(), c.Routine, item)
+		if err != nil {
+			return nil, err
+		}
+		mapped = append(mapped, res)
+	}
+	return HandleRoutineResult(i, destination, mapped)
+}
+
+func init() {
+	primitive_services.RegisterPrimitive(&Map{})
+}
@@ -0,0 +1,172 @@
+package primitives
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+var (
+	MapPathInvalidError      = errors.New("path must be a key or an array of keys and indexes")
+	MapPathNotFoundError     = errors.New("path not found")
+	MapPathNotContainerError = errors.New("path goes through a value that is not a map or an array")
+	MapIndexOutOfRangeError  = errors.New("array index out of range")
+	MapInvalidError          = errors.New("argument must be a map")
+)
+
+// ParsePath returns the elements of a path argument. A path is a single map
+// key, or an array of map keys and array indexes applied from left to right.
+// Indexes are returned as int.
+func ParsePath(arg interface{}) ([]interface{}, error) {
+	if list, ok := arg.([]interface{}); ok {
+		path := make([]interface{}, len(list))
+		for i, item := range list {
+			element, err := parsePathElement(item)
+			if err != nil {
+				return nil, err
+			}
+			path[i] = element
+		}
+		return path, nil
+	}
+	element, err := parsePathElement(arg)
+	if err != nil {
+		return nil, err
+	}
+	return []interface{}{element}, nil
+}
+
+// parsePathElement validates a single path element
+func parsePathElement(v interface{}) (interface{}, error) {
+	if key, ok := v.(string); ok {
+		return key, nil
+	}
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return int(rv.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return int(rv.Uint()), nil
+	default:
+		return nil, fmt.Errorf("%w: %v (%T)", MapPathInvalidError, v, v)
+	}
+}
+
+// LookupPath returns the value at path inside value, and false if any
+// element of the path does not exist
+func LookupPath(value interface{}, path []interface{}) (interface{}, bool) {
+	for _, element := range path {
+		switch key := element.(type) {
+		case string:
+			m, ok := value.(map[string]interface{})
+			if !ok {
+				return nil, false
+			}
+			if value, ok = m[key]; !ok {
+				return nil, false
+			}
+		case int:
+			list, ok := value.([]interface{})
+			if !ok || key < 0 || key >= len(list) {
+				return nil, false
+			}
+			value = list[key]
+		}
+	}
+	return value, true
+}
+
+// SetPath returns a copy of value in which the value at path is replaced
+// with newValue. Maps and arrays along the path are copied, never modified,
+// and missing map keys along the path are created as empty maps.
+func SetPath(value interface{}, path []interface{}, newValue interface{}) (interface{}, error) {
+	if len(path) == 0 {
+		return newValue, nil
+	}
+	switch key := path[0].(type) {
+	case string:
+		var m map[string]interface{}
+		if value != nil {
+			var ok bool
+			if m, ok = value.(map[string]interface{}); !ok {
+				return nil, fmt.Errorf("%w: key %q", MapPathNotContainerError, key)
+			}
+		}
+		child, err := SetPath(m[key], path[1:], newValue)
+		if err != nil {
+			return nil, err
+		}
+		result := copyMap(m)
+		result[key] = child
+		return result, nil
+	default:
+		index := key.(int)
+		list, ok := value.([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("%w: index %d", MapPathNotContainerError, index)
+		}
+		if index < 0 || index >= len(list) {
+			return nil, fmt.Errorf("%w: %d", MapIndexOutOfRangeError, index)
+		}
+		child, err := SetPath(list[index], path[1:], newValue)
+		if err != nil {
+			return nil, err
+		}
+		result := append([]interface{}{}, list...)
+		result[index] = child
+		return result, nil
+	}
+}
+
+// DeletePath returns a copy of value without the map key or array element
+// at path. Value is returned as it is if the path does not exist.
+func DeletePath(value interface{}, path []interface{}) interface{} {
+	if len(path) == 0 {
+		return value
+	}
+	if _, ok := LookupPath(value, path); !ok {
+		return value
+	}
+	switch key := path[0].(type) {
+	case string:
+		m := value.(map[string]interface{})
+		result := copyMap(m)
+		if len(path) == 1 {
+			delete(result, key)
+		} else {
+			result[key] = DeletePath(m[key], path[1:])
+		}
+		return result
+	default:
+		index := key.(int)
+		list := value.([]interface{})
+		if len(path) == 1 {
+			result := make([]interface{}, 0, len(list)-1)
+			result = append(result, list[:index]...)
+			return append(result, list[index+1:]...)
+		}
+		result := append([]interface{}{}, list...)
+		result[index] = DeletePath(list[index], path[1:])
+		return result
+	}
+}
+
+// SortedKeys returns the keys of m in ascending order
+func SortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// copyMap returns a shallow copy of m, or an empty map if m is nil
+func copyMap(m map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(m)+1)
+	for key, value := range m {
+		result[key] = value
+	}
+	return result
+}
@@ -0,0 +1,65 @@
+package primitives
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePath(t *testing.T) {
+	path, err := ParsePath("name")
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{"name"}, path)
+
+	path, err = ParsePath([]interface{}{"tags", int64(1)})
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{"tags", 1}, path)
+
+	_, err = ParsePath([]interface{}{"a", 1.5})
+	assert.ErrorIs(t, err, MapPathInvalidError)
+	_, err = ParsePath(nil)
+	assert.ErrorIs(t, err, MapPathInvalidError)
+}
+
+func TestSetPath(t *testing.T) {
+	original := map[string]interface{}{
+		"user": map[string]interface{}{"name": "ada"},
+		"tags": []interface{}{"a", "b"},
+	}
+
+	got, err := SetPath(original, []interface{}{"user", "name"}, "grace")
+	assert.NoError(t, err)
+	assert.Equal(t, "grace", got.(map[string]interface{})["user"].(map[string]interface{})["name"])
+	assert.Equal(t, "ada", original["user"].(map[string]interface{})["name"])
+
+	got, err = SetPath(original, []interface{}{"tags", 1}, "c")
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{"a", "c"}, got.(map[string]interface{})["tags"])
+	assert.Equal(t, []interface{}{"a", "b"}, original["tags"])
+
+	got, err = SetPath(original, []interface{}{"meta", "source"}, "llm")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"source": "llm"}, got.(map[string]interface{})["meta"])
+
+	_, err = SetPath(original, []interface{}{"tags", 5}, "x")
+	assert.ErrorIs(t, err, MapIndexOutOfRangeError)
+	_, err = SetPath(original, []interface{}{"tags", "x"}, "x")
+	assert.ErrorIs(t, err, MapPathNotContainerError)
+}
+
+func TestDeletePath(t *testing.T) {
+	original := map[string]interface{}{
+		"user": map[string]interface{}{"name": "ada", "age": int64(36)},
+		"tags": []interface{}{"a", "b", "c"},
+	}
+
+	got := DeletePath(original, []interface{}{"user", "age"})
+	assert.Equal(t, map[string]interface{}{"name": "ada"}, got.(map[string]interface{})["user"])
+	assert.Len(t, original["user"], 2)
+
+	got = DeletePath(original, []interface{}{"tags", 1})
+	assert.Equal(t, []interface{}{"a", "c"}, got.(map[string]interface{})["tags"])
+	assert.Len(t, original["tags"], 3)
+
+	assert.Equal(t, original, DeletePath(original, []interface{}{"missing", "key"}))
+}
@@ -0,0 +1,26 @@
+package primitives_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMap(t *testing.T) {
+	slots := map[string]interface{}{
+		"upper": parseRoutine(t, "uppercase _\n"),
+		"list":  []interface{}{"a", "b"},
+	}
+
+	got, err := runBlock(t, slots, `$result map $upper $list`)
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{"A", "B"}, got)
+
+	got, err = runBlock(t, slots, `$result map $upper []`)
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{}, got)
+
+	// The caller's slots are not changed by the routine
+	got, err = runBlock(t, slots, "$result map $upper $list\n$same eq $list [\"a\" \"b\"]\nthrow $same\n")
+	assert.ErrorContains(t, err, "true")
+}
@@ -0,0 +1,61 @@
+package primitives
+
+import (
+	"errors"
+
+	"github.com/hyperifyio/gnd/pkg/parsers"
+	"github.com/hyperifyio/gnd/pkg/primitive_services"
+	"github.com/hyperifyio/gnd/pkg/primitive_types"
+)
+
+var MatchRequiresArgumentsError = errors.New("match: requires a value and at least one case")
+
+// Match represents the match primitive
+type Match struct{}
+
+var _ primitive_types.Primitive = &Match{}
+var _ primitive_types.BlockSuccessResultHandler = &Match{}
+
+func (m *Match) Name() string {
+	return "/gnd/match"
+}
+
+// Execute compares the value against the patterns of the pattern/branch pairs
+// using eq semantics. The branch of the first matching pattern is chosen; a
+// trailing unpaired argument is the default branch. A routine branch becomes an
+// ExecResult that runs with the value as its input, any other branch is the
+// result itself. Without a match or default the result is nil.
+func (m *Match) Execute(args []interface{}) (interface{}, error) {
+	if len(args) < 2 {
+		return nil, MatchRequiresArgumentsError
+	}
+
+	value := args[0]
+	cases := args[1:]
+	for idx := 0; idx+1 < len(cases); idx += 2 {
+		if ValuesEqual(value, cases[idx]) {
+			return matchBranch(value, cases[idx+1]), nil
+		}
+	}
+	if len(cases)%2 == 1 {
+		return matchBranch(value, cases[len(cases)-1]), nil
+	}
+	return nil, nil
+}
+
+// HandleBlockSuccessResult runs the routine chosen by Execute
+func (m *Match) HandleBlockSuccessResult(result interface{}, i primitive_types.Interpreter, destination *parsers.PropertyRef, _ []*parsers.Instruction) (interface{}, error) {
+	return HandleRoutineResult(i, destination, result)
+}
+
+// matchBranch returns the result of a chosen branch
+func matchBranch(value, branch interface{}) interface{} {
+	if routine, ok := ToRoutine(branch); ok {
+		return NewExecResult(routine, []interface{}{value})
+	}
+	return branch
+}
+
+func init() {
+	primitive_services.RegisterPrimitive(&Match{})
+}
@@ -0,0 +1,60 @@
+package primitives_test
+
+import (
+	"testing"
+
+	"github.com/hyperifyio/gnd/pkg/primitives"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatch(t *testing.T) {
+	slots := map[string]interface{}{"r": concatRoutine()}
+
+	tests := []struct {
+		name   string
+		source string
+		want   interface{}
+	}{
+		{
+			name:   "first matching case",
+			source: `$result match 3 1 "one" 3 "three" 3 "again"`,
+			want:   "three",
+		},
+		{
+			name:   "numbers compare by value",
+			source: `$result match 2.0 2 "two"`,
+			want:   "two",
+		},
+		{
+			name:   "default",
+			source: `$result match "x" "a" "alpha" "other"`,
+			want:   "other",
+		},
+		{
+			name:   "no match without default",
+			source: `$result match "x" "a" "alpha"`,
+			want:   nil,
+		},
+		{
+			name:   "routine branch receives the value",
+			source: `$result match "a" "a" $r "other"`,
+			want:   "a",
+		},
+		{
+			name:   "routine default",
+			source: `$result match "b" "a" "alpha" $r`,
+			want:   "b",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := runBlock(t, slots, tt.source)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	_, err := (&primitives.Match{}).Execute([]interface{}{1})
+	assert.ErrorIs(t, err, primitives.MatchRequiresArgumentsError)
+}
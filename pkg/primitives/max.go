@@ -0,0 +1,63 @@
+package primitives
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/hyperifyio/gnd/pkg/primitive_services"
+	"github.com/hyperifyio/gnd/pkg/primitive_types"
+)
+
+var MaxRequiresArgumentsError = errors.New("max: requires at least one number")
+
+// Max represents the max primitive
+type Max struct{}
+
+var _ primitive_types.Primitive = &Max{}
+
+func (p *Max) Name() string {
+	return "/gnd/max"
+}
+
+// Execute returns the largest argument, or the largest element if the only
+// argument is an array
+func (p *Max) Execute(args []interface{}) (interface{}, error) {
+	return extremum("max", args, 1, MaxRequiresArgumentsError)
+}
+
+// extremum implements min and max: it returns the number for which the
+// comparison with every other number is never the opposite of want,
+// converted to the promoted type of all numbers
+func extremum(name string, args []interface{}, want int, requiresErr error) (interface{}, error) {
+	if len(args) == 1 {
+		if list, ok := args[0].([]interface{}); ok {
+			args = list
+		}
+	}
+	if len(args) == 0 {
+		return nil, requiresErr
+	}
+	numbers, err := ToNumbers(args)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+
+	// ToNumbers only returns numbers that ToBigFloat accepts
+	best := numbers[0]
+	bestValue, _ := ToBigFloat(best)
+	for _, n := range numbers[1:] {
+		value, _ := ToBigFloat(n)
+		if value.Cmp(bestValue) == want {
+			best, bestValue = n, value
+		}
+	}
+	result, err := ConvertNumber(best, PromotedType(numbers))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	return result, nil
+}
+
+func init() {
+	primitive_services.RegisterPrimitive(&Max{})
+}
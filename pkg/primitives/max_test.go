@@ -0,0 +1,33 @@
+package primitives
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMax(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []interface{}
+		want    interface{}
+		wantErr error
+	}{
+		{"int64", []interface{}{int64(3), int64(1), int64(2)}, int64(3), nil},
+		{"array", []interface{}{[]interface{}{uint8(3), uint16(9)}}, uint64(9), nil},
+		{"promoted", []interface{}{int64(1), 0.5}, 1.0, nil},
+		{"no arguments", []interface{}{}, nil, MaxRequiresArgumentsError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := (&Max{}).Execute(tt.args)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
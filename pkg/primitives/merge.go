@@ -0,0 +1,43 @@
+package primitives
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/hyperifyio/gnd/pkg/primitive_services"
+	"github.com/hyperifyio/gnd/pkg/primitive_types"
+)
+
+var MergeRequiresArgumentsError = errors.New("merge: requires at least one map")
+
+// Merge represents the merge primitive
+type Merge struct{}
+
+var _ primitive_types.Primitive = &Merge{}
+
+func (p *Merge) Name() string {
+	return "/gnd/merge"
+}
+
+// Execute returns a new map with the entries of all argument maps. When
+// several maps have the same key, the value of the last one wins.
+func (p *Merge) Execute(args []interface{}) (interface{}, error) {
+	if len(args) == 0 {
+		return nil, MergeRequiresArgumentsError
+	}
+	result := make(map[string]interface{})
+	for idx, arg := range args {
+		m, ok := arg.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("merge: argument %d: %w", idx+1, MapInvalidError)
+		}
+		for key, value := range m {
+			result[key] = value
+		}
+	}
+	return result, nil
+}
+
+func init() {
+	primitive_services.RegisterPrimitive(&Merge{})
+}
@@ -0,0 +1,22 @@
+package primitives
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMerge(t *testing.T) {
+	a := map[string]interface{}{"x": int64(1), "y": int64(2)}
+	b := map[string]interface{}{"y": int64(3), "z": int64(4)}
+
+	got, err := (&Merge{}).Execute([]interface{}{a, b})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"x": int64(1), "y": int64(3), "z": int64(4)}, got)
+	assert.Equal(t, int64(2), a["y"])
+
+	_, err = (&Merge{}).Execute([]interface{}{a, "b"})
+	assert.ErrorIs(t, err, MapInvalidError)
+	_, err = (&Merge{}).Execute([]interface{}{})
+	assert.ErrorIs(t, err, MergeRequiresArgumentsError)
+}
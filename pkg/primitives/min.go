@@ -0,0 +1,29 @@
+package primitives
+
+import (
+	"errors"
+
+	"github.com/hyperifyio/gnd/pkg/primitive_services"
+	"github.com/hyperifyio/gnd/pkg/primitive_types"
+)
+
+var MinRequiresArgumentsError = errors.New("min: requires at least one number")
+
+// Min represents the min primitive
+type Min struct{}
+
+var _ primitive_types.Primitive = &Min{}
+
+func (p *Min) Name() string {
+	return "/gnd/min"
+}
+
+// Execute returns the smallest argument, or the smallest element if the only
+// argument is an array
+func (p *Min) Execute(args []interface{}) (interface{}, error) {
+	return extremum("min", args, -1, MinRequiresArgumentsError)
+}
+
+func init() {
+	primitive_services.RegisterPrimitive(&Min{})
+}
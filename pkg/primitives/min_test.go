@@ -0,0 +1,35 @@
+package primitives
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMin(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []interface{}
+		want    interface{}
+		wantErr error
+	}{
+		{"int64", []interface{}{int64(3), int64(1), int64(2)}, int64(1), nil},
+		{"array", []interface{}{[]interface{}{int64(3), int64(-1)}}, int64(-1), nil},
+		{"promoted", []interface{}{int64(1), 2.5}, 1.0, nil},
+		{"single", []interface{}{"4"}, int64(4), nil},
+		{"empty array", []interface{}{[]interface{}{}}, nil, MinRequiresArgumentsError},
+		{"invalid", []interface{}{int64(1), "a"}, nil, ArithmeticInvalidArgumentError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := (&Min{}).Execute(tt.args)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package primitives
+
+import (
+	"errors"
+	"math"
+	"math/big"
+
+	"github.com/hyperifyio/gnd/pkg/primitive_services"
+	"github.com/hyperifyio/gnd/pkg/primitive_types"
+)
+
+var ModRequiresArgumentsError = errors.New("mod: requires exactly two arguments")
+
+// Mod represents the mod primitive
+type Mod struct{}
+
+var _ primitive_types.Primitive = &Mod{}
+
+func (p *Mod) Name() string {
+	return "/gnd/mod"
+}
+
+// Execute returns the remainder of dividing the first argument by the second.
+// The result has the sign of the first argument.
+func (p *Mod) Execute(args []interface{}) (interface{}, error) {
+	if len(args) != 2 {
+		return nil, ModRequiresArgumentsError
+	}
+	return foldArithmetic("mod", args, arithmeticOp{
+		ints: func(a, b *big.Int) (*big.Int, error) {
+			if b.Sign() == 0 {
+				return nil, ArithmeticDivisionByZeroError
+			}
+			return new(big.Int).Rem(a, b), nil
+		},
+		floats: func(a, b float64) (float64, error) {
+			if b == 0 {
+				return 0, ArithmeticDivisionByZeroError
+			}
+			return math.Mod(a, b), nil
+		},
+	})
+}
+
+func init() {
+	primitive_services.RegisterPrimitive(&Mod{})
+}
@@ -0,0 +1,35 @@
+package primitives
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMod(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []interface{}
+		want    interface{}
+		wantErr error
+	}{
+		{"integer", []interface{}{int64(7), int64(3)}, int64(1), nil},
+		{"sign of dividend", []interface{}{int64(-7), int64(3)}, int64(-1), nil},
+		{"float", []interface{}{7.5, int64(2)}, 1.5, nil},
+		{"by zero", []interface{}{int64(7), int64(0)}, nil, ArithmeticDivisionByZeroError},
+		{"float by zero", []interface{}{7.5, 0.0}, nil, ArithmeticDivisionByZeroError},
+		{"three arguments", []interface{}{int64(7), int64(3), int64(2)}, nil, ModRequiresArgumentsError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := (&Mod{}).Execute(tt.args)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
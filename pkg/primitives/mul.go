@@ -0,0 +1,39 @@
+package primitives
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/hyperifyio/gnd/pkg/primitive_services"
+	"github.com/hyperifyio/gnd/pkg/primitive_types"
+)
+
+var MulRequiresArgumentsError = errors.New("mul: requires at least two arguments")
+
+// Mul represents the mul primitive
+type Mul struct{}
+
+var _ primitive_types.Primitive = &Mul{}
+
+func (p *Mul) Name() string {
+	return "/gnd/mul"
+}
+
+// Execute returns the product of the arguments
+func (p *Mul) Execute(args []interface{}) (interface{}, error) {
+	if len(args) < 2 {
+		return nil, MulRequiresArgumentsError
+	}
+	return foldArithmetic("mul", args, arithmeticOp{
+		ints: func(a, b *big.Int) (*big.Int, error) {
+			return new(big.Int).Mul(a, b), nil
+		},
+		floats: func(a, b float64) (float64, error) {
+			return a * b, nil
+		},
+	})
+}
+
+func init() {
+	primitive_services.RegisterPrimitive(&Mul{})
+}
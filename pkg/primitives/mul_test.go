@@ -0,0 +1,34 @@
+package primitives
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMul(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []interface{}
+		want    interface{}
+		wantErr error
+	}{
+		{"int64", []interface{}{int64(2), int64(3), int64(4)}, int64(24), nil},
+		{"float", []interface{}{int64(2), 0.25}, 0.5, nil},
+		{"int32 overflow", []interface{}{int32(1 << 16), int32(1 << 15)}, nil, ArithmeticOverflowError},
+		{"uint64 beyond int64", []interface{}{uint64(1 << 62), uint64(2)}, uint64(1 << 63), nil},
+		{"one argument", []interface{}{int64(2)}, nil, MulRequiresArgumentsError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := (&Mul{}).Execute(tt.args)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
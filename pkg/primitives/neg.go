@@ -0,0 +1,48 @@
+package primitives
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/hyperifyio/gnd/pkg/primitive_services"
+	"github.com/hyperifyio/gnd/pkg/primitive_types"
+)
+
+var NegRequiresArgumentError = errors.New("neg: requires exactly one argument")
+
+// Neg represents the neg primitive
+type Neg struct{}
+
+var _ primitive_types.Primitive = &Neg{}
+
+func (p *Neg) Name() string {
+	return "/gnd/neg"
+}
+
+// Execute returns the argument with its sign flipped. The result keeps the
+// type of the argument, so negating a non-zero unsigned integer overflows.
+func (p *Neg) Execute(args []interface{}) (interface{}, error) {
+	if len(args) != 1 {
+		return nil, NegRequiresArgumentError
+	}
+	n, err := ToNumber(args[0])
+	if err != nil {
+		return nil, fmt.Errorf("neg: %w", err)
+	}
+	t := PromotedType([]interface{}{n})
+	var result interface{}
+	if isFloatType(t) {
+		result, err = fromFloat64(-toFloat64(n), t)
+	} else {
+		result, err = fromBigInt(new(big.Int).Neg(toBigInt(n)), t)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("neg: %w", err)
+	}
+	return result, nil
+}
+
+func init() {
+	primitive_services.RegisterPrimitive(&Neg{})
+}
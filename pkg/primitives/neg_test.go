@@ -0,0 +1,37 @@
+package primitives
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNeg(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []interface{}
+		want    interface{}
+		wantErr error
+	}{
+		{"int64", []interface{}{int64(3)}, int64(-3), nil},
+		{"type kept", []interface{}{int8(-127)}, int8(127), nil},
+		{"min int8", []interface{}{int8(-128)}, nil, ArithmeticOverflowError},
+		{"unsigned zero", []interface{}{uint(0)}, uint(0), nil},
+		{"unsigned", []interface{}{uint(1)}, nil, ArithmeticOverflowError},
+		{"float", []interface{}{1.5}, -1.5, nil},
+		{"string", []interface{}{"-2"}, int64(2), nil},
+		{"two arguments", []interface{}{int64(1), int64(2)}, nil, NegRequiresArgumentError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := (&Neg{}).Execute(tt.args)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
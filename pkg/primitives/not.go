@@ -0,0 +1,32 @@
+package primitives
+
+import (
+	"errors"
+
+	"github.com/hyperifyio/gnd/pkg/primitive_services"
+	"github.com/hyperifyio/gnd/pkg/primitive_types"
+)
+
+var NotRequiresArgumentError = errors.New("not: requires exactly one argument")
+
+// Not represents the not primitive
+type Not struct{}
+
+var _ primitive_types.Primitive = &Not{}
+
+func (p *Not) Name() string {
+	return "/gnd/not"
+}
+
+// Execute returns the negated truth value of the argument, using the
+// truthiness rules of bool
+func (p *Not) Execute(args []interface{}) (interface{}, error) {
+	if len(args) != 1 {
+		return nil, NotRequiresArgumentError
+	}
+	return !Truthy(args[0]), nil
+}
+
+func init() {
+	primitive_services.RegisterPrimitive(&Not{})
+}
@@ -0,0 +1,31 @@
+package primitives
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNot(t *testing.T) {
+	for _, tc := range []struct {
+		arg  interface{}
+		want bool
+	}{
+		{true, false},
+		{false, true},
+		{nil, true},
+		{int64(0), true},
+		{0.5, false},
+		{"", true},
+		{"false", false},
+		{[]interface{}{}, true},
+		{map[string]interface{}{"a": int64(1)}, false},
+	} {
+		got, err := (&Not{}).Execute([]interface{}{tc.arg})
+		assert.NoError(t, err, "%v", tc.arg)
+		assert.Equal(t, tc.want, got, "%v", tc.arg)
+	}
+
+	_, err := (&Not{}).Execute([]interface{}{})
+	assert.ErrorIs(t, err, NotRequiresArgumentError)
+}
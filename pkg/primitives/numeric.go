@@ -0,0 +1,85 @@
+package primitives
+
+import (
+	"math"
+	"math/big"
+	"reflect"
+	"strings"
+)
+
+// ToBigFloat converts any Go integer or float value into an exact big.Float.
+// The second return value is false for non-numeric values, NaN and infinities.
+func ToBigFloat(v interface{}) (*big.Float, bool) {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return new(big.Float).SetInt64(rv.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return new(big.Float).SetUint64(rv.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		f := rv.Float()
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			return nil, false
+		}
+		return new(big.Float).SetFloat64(f), true
+	default:
+		return nil, false
+	}
+}
+
+// ValuesEqual compares two values. Numbers are compared by value regardless
+// of their Go type, so 1 and 1.0 are equal; arrays and maps are compared
+// element by element, and everything else with reflect.DeepEqual.
+func ValuesEqual(a, b interface{}) bool {
+	if x, ok := ToBigFloat(a); ok {
+		if y, ok := ToBigFloat(b); ok {
+			return x.Cmp(y) == 0
+		}
+		return false
+	}
+
+	switch x := a.(type) {
+	case []interface{}:
+		y, ok := b.([]interface{})
+		if !ok || len(x) != len(y) {
+			return false
+		}
+		for i := range x {
+			if !ValuesEqual(x[i], y[i]) {
+				return false
+			}
+		}
+		return true
+	case map[string]interface{}:
+		y, ok := b.(map[string]interface{})
+		if !ok || len(x) != len(y) {
+			return false
+		}
+		for key, value := range x {
+			other, ok := y[key]
+			if !ok || !ValuesEqual(value, other) {
+				return false
+			}
+		}
+		return true
+	default:
+		return reflect.DeepEqual(a, b)
+	}
+}
+
+// CompareValues orders two numbers by value or two strings lexically. It
+// returns -1, 0 or 1, and false if the values cannot be compared.
+func CompareValues(a, b interface{}) (int, bool) {
+	if x, ok := ToBigFloat(a); ok {
+		if y, ok := ToBigFloat(b); ok {
+			return x.Cmp(y), true
+		}
+		return 0, false
+	}
+	if x, ok := a.(string); ok {
+		if y, ok := b.(string); ok {
+			return strings.Compare(x, y), true
+		}
+	}
+	return 0, false
+}
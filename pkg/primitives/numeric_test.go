@@ -0,0 +1,56 @@
+package primitives
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToBigFloat(t *testing.T) {
+	for _, v := range []interface{}{int(3), int8(3), int16(3), int32(3), int64(3), uint(3), uint8(3), uint16(3), uint32(3), uint64(3), float32(3), float64(3)} {
+		f, ok := ToBigFloat(v)
+		assert.True(t, ok, "%T", v)
+		assert.Equal(t, "3", f.Text('g', 10), "%T", v)
+	}
+
+	for _, v := range []interface{}{nil, "3", true, math.NaN(), math.Inf(1), []interface{}{1}} {
+		_, ok := ToBigFloat(v)
+		assert.False(t, ok, "%v", v)
+	}
+
+	f, ok := ToBigFloat(uint64(math.MaxUint64))
+	assert.True(t, ok)
+	assert.Equal(t, "18446744073709551615", f.Text('f', 0))
+}
+
+func TestValuesEqual(t *testing.T) {
+	assert.True(t, ValuesEqual(int64(1), 1.0))
+	assert.True(t, ValuesEqual(uint8(255), int(255)))
+	assert.False(t, ValuesEqual(int64(9007199254740993), float64(9007199254740992)))
+	assert.False(t, ValuesEqual(int64(1), "1"))
+	assert.False(t, ValuesEqual(math.NaN(), math.NaN()))
+	assert.True(t, ValuesEqual([]interface{}{int64(1), "a"}, []interface{}{1.0, "a"}))
+	assert.False(t, ValuesEqual([]interface{}{int64(1)}, []interface{}{int64(1), int64(2)}))
+	assert.True(t, ValuesEqual(map[string]interface{}{"a": int64(1)}, map[string]interface{}{"a": float32(1)}))
+	assert.False(t, ValuesEqual(map[string]interface{}{"a": int64(1)}, map[string]interface{}{"b": int64(1)}))
+	assert.True(t, ValuesEqual(nil, nil))
+}
+
+func TestCompareValues(t *testing.T) {
+	for _, tc := range []struct {
+		a, b interface{}
+		want int
+		ok   bool
+	}{
+		{int64(1), 2.5, -1, true},
+		{uint8(3), int64(3), 0, true},
+		{"b", "a", 1, true},
+		{"1", int64(1), 0, false},
+		{nil, nil, 0, false},
+	} {
+		got, ok := CompareValues(tc.a, tc.b)
+		assert.Equal(t, tc.ok, ok, "%v %v", tc.a, tc.b)
+		assert.Equal(t, tc.want, got, "%v %v", tc.a, tc.b)
+	}
+}
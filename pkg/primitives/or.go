@@ -0,0 +1,37 @@
+package primitives
+
+import (
+	"errors"
+
+	"github.com/hyperifyio/gnd/pkg/parsers"
+	"github.com/hyperifyio/gnd/pkg/primitive_services"
+	"github.com/hyperifyio/gnd/pkg/primitive_types"
+)
+
+var OrRequiresArgumentsError = errors.New("or: requires at least two arguments")
+
+// Or represents the or primitive
+type Or struct{}
+
+var _ primitive_types.Primitive = &Or{}
+var _ primitive_types.BlockSuccessResultHandler = &Or{}
+
+func (p *Or) Name() string {
+	return "/gnd/or"
+}
+
+// Execute returns true if any argument is true, using the truthiness rules of bool.
+// Routine arguments are run by HandleBlockSuccessResult.
+func (p *Or) Execute(args []interface{}) (interface{}, error) {
+	return executeLogic(args, true, OrRequiresArgumentsError)
+}
+
+// HandleBlockSuccessResult runs routine arguments in order until the result
+// is known
+func (p *Or) HandleBlockSuccessResult(result interface{}, i primitive_types.Interpreter, destination *parsers.PropertyRef, _ []*parsers.Instruction) (interface{}, error) {
+	return handleLogicResult(p.Name(), result, i, destination)
+}
+
+func init() {
+	primitive_services.RegisterPrimitive(&Or{})
+}
@@ -0,0 +1,44 @@
+package primitives_test
+
+import (
+	"testing"
+
+	"github.com/hyperifyio/gnd/pkg/primitives"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOr(t *testing.T) {
+	or := &primitives.Or{}
+
+	got, err := or.Execute([]interface{}{nil, int64(0), "yes"})
+	assert.NoError(t, err)
+	assert.Equal(t, true, got)
+
+	got, err = or.Execute([]interface{}{false, []interface{}{}})
+	assert.NoError(t, err)
+	assert.Equal(t, false, got)
+
+	_, err = or.Execute([]interface{}{})
+	assert.ErrorIs(t, err, primitives.OrRequiresArgumentsError)
+
+	slots := map[string]interface{}{
+		"yes":  parseRoutine(t, "bool 1\n"),
+		"no":   parseRoutine(t, "bool 0\n"),
+		"boom": parseRoutine(t, "throw \"evaluated\"\n"),
+	}
+
+	got, err = runBlock(t, slots, `$result or $no $yes`)
+	assert.NoError(t, err)
+	assert.Equal(t, true, got)
+
+	got, err = runBlock(t, slots, `$result or $yes $boom`)
+	assert.NoError(t, err)
+	assert.Equal(t, true, got)
+
+	got, err = runBlock(t, slots, `$result or 0 $no`)
+	assert.NoError(t, err)
+	assert.Equal(t, false, got)
+
+	_, err = runBlock(t, slots, `$result or $no $boom`)
+	assert.ErrorContains(t, err, "evaluated")
+}
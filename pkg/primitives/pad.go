@@ -0,0 +1,40 @@
+package primitives
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+var PadEmptyError = errors.New("padding must not be empty")
+
+// padString returns the padding needed to widen args[0] to the width given
+// in args[1], repeating and truncating the optional padding in args[2],
+// which defaults to a space
+func padString(name string, args []interface{}) (string, string, error) {
+	s, err := stringArg(name, args, 0)
+	if err != nil {
+		return "", "", err
+	}
+	width, err := intArg(name, args, 1)
+	if err != nil {
+		return "", "", err
+	}
+	padding := " "
+	if len(args) == 3 {
+		if padding, err = stringArg(name, args, 2); err != nil {
+			return "", "", err
+		}
+		if padding == "" {
+			return "", "", fmt.Errorf("%s: %w", name, PadEmptyError)
+		}
+	}
+
+	missing := width - utf8.RuneCountInString(s)
+	if missing <= 0 {
+		return s, "", nil
+	}
+	runes := []rune(strings.Repeat(padding, missing/utf8.RuneCountInString(padding)+1))
+	return s, string(runes[:missing]), nil
+}
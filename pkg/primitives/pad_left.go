@@ -0,0 +1,36 @@
+package primitives
+
+import (
+	"errors"
+
+	"github.com/hyperifyio/gnd/pkg/primitive_services"
+	"github.com/hyperifyio/gnd/pkg/primitive_types"
+)
+
+var PadLeftRequiresArgumentsError = errors.New("pad-left: requires a string, a width and an optional padding")
+
+// PadLeft represents the pad-left primitive
+type PadLeft struct{}
+
+var _ primitive_types.Primitive = &PadLeft{}
+
+func (p *PadLeft) Name() string {
+	return "/gnd/pad-left"
+}
+
+// Execute adds padding to the start of the string until it is width
+// characters long. Longer strings are returned as they are.
+func (p *PadLeft) Execute(args []interface{}) (interface{}, error) {
+	if len(args) < 2 || len(args) > 3 {
+		return nil, PadLeftRequiresArgumentsError
+	}
+	s, padding, err := padString("pad-left", args)
+	if err != nil {
+		return nil, err
+	}
+	return padding + s, nil
+}
+
+func init() {
+	primitive_services.RegisterPrimitive(&PadLeft{})
+}
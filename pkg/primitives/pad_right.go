@@ -0,0 +1,36 @@
+package primitives
+
+import (
+	"errors"
+
+	"github.com/hyperifyio/gnd/pkg/primitive_services"
+	"github.com/hyperifyio/gnd/pkg/primitive_types"
+)
+
+var PadRightRequiresArgumentsError = errors.New("pad-right: requires a string, a width and an optional padding")
+
+// PadRight represents the pad-right primitive
+type PadRight struct{}
+
+var _ primitive_types.Primitive = &PadRight{}
+
+func (p *PadRight) Name() string {
+	return "/gnd/pad-right"
+}
+
+// Execute adds padding to the end of the string until it is width
+// characters long. Longer strings are returned as they are.
+func (p *PadRight) Execute(args []interface{}) (interface{}, error) {
+	if len(args) < 2 || len(args) > 3 {
+		return nil, PadRightRequiresArgumentsError
+	}
+	s, padding, err := padString("pad-right", args)
+	if err != nil {
+		return nil, err
+	}
+	return s + padding, nil
+}
+
+func init() {
+	primitive_services.RegisterPrimitive(&PadRight{})
+}
@@ -0,0 +1,48 @@
+package primitives
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPadLeft(t *testing.T) {
+	tests := []struct {
+		name string
+		args []interface{}
+		want string
+	}{
+		{"spaces", []interface{}{"7", int64(3)}, "  7"},
+		{"padding", []interface{}{"7", int64(3), "0"}, "007"},
+		{"long padding is truncated", []interface{}{"x", int64(4), "ab"}, "abax"},
+		{"width counts characters", []interface{}{"ä", int64(2), "ö"}, "öä"},
+		{"long string", []interface{}{"hello", int64(2)}, "hello"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := (&PadLeft{}).Execute(tt.args)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	_, err := (&PadLeft{}).Execute([]interface{}{"x", int64(3), ""})
+	assert.ErrorIs(t, err, PadEmptyError)
+	_, err = (&PadLeft{}).Execute([]interface{}{"x", "wide"})
+	assert.ErrorIs(t, err, IntegerArgumentInvalidError)
+	_, err = (&PadLeft{}).Execute([]interface{}{"x"})
+	assert.ErrorIs(t, err, PadLeftRequiresArgumentsError)
+}
+
+func TestPadRight(t *testing.T) {
+	got, err := (&PadRight{}).Execute([]interface{}{"ab", int64(5), ".-"})
+	assert.NoError(t, err)
+	assert.Equal(t, "ab.-.", got)
+
+	got, err = (&PadRight{}).Execute([]interface{}{"ab", int64(1)})
+	assert.NoError(t, err)
+	assert.Equal(t, "ab", got)
+
+	_, err = (&PadRight{}).Execute([]interface{}{"x"})
+	assert.ErrorIs(t, err, PadRightRequiresArgumentsError)
+}
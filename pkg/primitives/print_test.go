@@ -25,6 +25,12 @@ func TestPrint(t *testing.T) {
 			want:    "\"Hello, World!\"",
 			wantErr: false,
 		},
+		{
+			name:    "numeric string argument",
+			args:    []interface{}{"42", "[ok]"},
+			want:    "42 [ok]",
+			wantErr: false,
+		},
 		{
 			name:    "non-string argument",
 			args:    []interface{}{123},
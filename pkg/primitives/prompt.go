@@ -1,29 +1,39 @@
 package primitives
 
 import (
+	"context"
 	"errors"
 	"fmt"
-	"github.com/hyperifyio/gnd/pkg/helpers"
 	"github.com/hyperifyio/gnd/pkg/parsers"
 	"github.com/hyperifyio/gnd/pkg/primitive_services"
 	"github.com/hyperifyio/gnd/pkg/primitive_types"
 	"github.com/hyperifyio/gnd/pkg/prompts"
-	"net/http"
+	"github.com/hyperifyio/gnd/pkg/runtime_errors"
 )
 
 var PromptExpectsAtLeastOneArgument = errors.New("prompt expects at least 1 argument")
 var PromptApiKeyNotSet = errors.New("OPENAI_API_KEY environment variable not set")
 
-// Prompt represents the prompt primitive
-type Prompt struct{}
+// Prompt represents the prompt primitive. The backend is selected with
+// prompts.LoadConfig on every call unless Backend and Config are set.
+type Prompt struct {
+	Backend prompts.Backend
+	Config  prompts.PromptConfig
+}
 
-var _ primitive_types.Primitive = &Prompt{}
+var _ primitive_types.ContextPrimitive = &Prompt{}
 
 func (p *Prompt) Name() string {
 	return "/gnd/prompt"
 }
 
 func (p *Prompt) Execute(args []interface{}) (interface{}, error) {
+	return p.ExecuteWithContext(context.Background(), args)
+}
+
+// ExecuteWithContext sends the prompt to the backend. The request is aborted
+// when ctx is done, e.g. when the task running the prompt is cancelled.
+func (p *Prompt) ExecuteWithContext(ctx context.Context, args []interface{}) (interface{}, error) {
 	if len(args) < 1 {
 		return nil, PromptExpectsAtLeastOneArgument
 	}
@@ -33,21 +43,22 @@ func (p *Prompt) Execute(args []interface{}) (interface{}, error) {
 		return nil, fmt.Errorf("prompt: %v", err)
 	}
 
-	// Get API key from environment
-	apiKey := helpers.GetEnv("OPENAI_API_KEY", "")
-	if apiKey == "" {
-		return nil, PromptApiKeyNotSet
+	backend, config := p.Backend, p.Config
+	if backend == nil {
+		backend, config, err = prompts.LoadBackend()
+		if err != nil {
+			return nil, fmt.Errorf("prompt: %w", err)
+		}
+		if config.RequiresApiKey() {
+			return nil, PromptApiKeyNotSet
+		}
 	}
 
-	config := prompts.DefaultConfig()
-	config.BaseURL = helpers.GetEnv("OPENAI_API_URL", config.BaseURL)
-	config.Model = helpers.GetEnv("OPENAI_MODEL", config.Model)
-
-	client := &http.Client{
-		Timeout: config.Timeout,
+	result, err := backend.Complete(ctx, config.NewCompletionRequest(prompt))
+	if err != nil && ctx.Err() != nil {
+		return nil, runtime_errors.NewContextError(ctx.Err())
 	}
-
-	return prompts.PromptClientImpl(client, config, apiKey, prompt)
+	return result, err
 }
 
 func init() {
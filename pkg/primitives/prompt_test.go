@@ -1,11 +1,17 @@
 package primitives
 
 import (
+	"context"
+	"errors"
 	"github.com/hyperifyio/gnd/pkg/loggers"
 	"github.com/hyperifyio/gnd/pkg/parsers"
+	"github.com/hyperifyio/gnd/pkg/prompts"
+	"github.com/hyperifyio/gnd/pkg/runtime_errors"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 )
 
 func TestPrompt(t *testing.T) {
@@ -78,3 +84,53 @@ func TestPromptNoAPIKey(t *testing.T) {
 		t.Error("Prompt.Execute() expected error with no API key, got nil")
 	}
 }
+
+func TestPromptFakeBackend(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "responses.json")
+	if err := os.WriteFile(path, []byte(`{"responses": {}, "default": "yes"}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("GND_PROMPT_CONFIG", "")
+	t.Setenv("GND_PROMPT_BACKEND", prompts.FakeBackendName)
+	t.Setenv("GND_PROMPT_FAKE_FILE", path)
+
+	p := &Prompt{}
+	got, err := p.Execute([]interface{}{"Are you there?"})
+	if err != nil {
+		t.Fatalf("Prompt.Execute() error = %v", err)
+	}
+	if got != "yes" {
+		t.Errorf("Prompt.Execute() = %v, want yes", got)
+	}
+
+	if err := os.WriteFile(path, []byte(`{"responses": {}}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	p = &Prompt{Backend: prompts.NewFakeBackend(path), Config: prompts.DefaultConfig()}
+	if _, err := p.Execute([]interface{}{"Who are you?"}); !errors.Is(err, prompts.FakeBackendNoResponseError) {
+		t.Errorf("Prompt.Execute() error = %v, want %v", err, prompts.FakeBackendNoResponseError)
+	}
+}
+
+// hangingBackend never replies, like a hung model server
+type hangingBackend struct{}
+
+func (b *hangingBackend) Complete(ctx context.Context, _ *prompts.CompletionRequest) (string, error) {
+	<-ctx.Done()
+	return "", ctx.Err()
+}
+
+func TestPromptCancelled(t *testing.T) {
+	p := &Prompt{Backend: &hangingBackend{}, Config: prompts.DefaultConfig()}
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(10*time.Millisecond, cancel)
+
+	_, err := p.ExecuteWithContext(ctx, []interface{}{"Are you there?"})
+	var value *runtime_errors.ErrorValue
+	if !errors.As(err, &value) || value.Code != runtime_errors.CancelledErrorCode {
+		t.Errorf("Prompt.ExecuteWithContext() error = %v, want code %s", err, runtime_errors.CancelledErrorCode)
+	}
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Prompt.ExecuteWithContext() error = %v, want %v", err, context.Canceled)
+	}
+}
@@ -0,0 +1,93 @@
+package primitives
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/hyperifyio/gnd/pkg/parsers"
+	"github.com/hyperifyio/gnd/pkg/primitive_services"
+	"github.com/hyperifyio/gnd/pkg/primitive_types"
+)
+
+var RangeInvalidArgumentsError = errors.New("range expects an end, or a start, an end and an optional step")
+var RangeZeroStepError = errors.New("range: step must not be zero")
+var RangeTooLargeError = errors.New("range: too many values")
+
+// Range represents the range primitive
+type Range struct{}
+
+var _ primitive_types.Primitive = &Range{}
+
+func (r *Range) Name() string {
+	return "/gnd/range"
+}
+
+// Execute returns the int64 values from start up to but not including end.
+// With a single argument the range starts from 0.
+func (r *Range) Execute(args []interface{}) (interface{}, error) {
+	if len(args) < 1 || len(args) > 3 {
+		return nil, RangeInvalidArgumentsError
+	}
+
+	bounds := make([]int, len(args))
+	for idx, arg := range args {
+		v, err := parsers.ParseInt(arg)
+		if err != nil {
+			return nil, fmt.Errorf("range: %w", err)
+		}
+		bounds[idx] = v
+	}
+
+	start, end, step := 0, bounds[0], 1
+	if len(bounds) > 1 {
+		start, end = bounds[0], bounds[1]
+	}
+	if len(bounds) > 2 {
+		step = bounds[2]
+	}
+	if step == 0 {
+		return nil, RangeZeroStepError
+	}
+
+	count, err := rangeCount(start, end, step)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkLoopIterations("range", count); err != nil {
+		return nil, err
+	}
+
+	result := make([]interface{}, 0, count)
+	for idx := 0; idx < count; idx++ {
+		result = append(result, int64(start+idx*step))
+	}
+	return result, nil
+}
+
+// rangeCount returns the number of values from start up to but not including
+// end. The distance is computed in uint64, so bounds far apart cannot
+// overflow.
+func rangeCount(start, end, step int) (int, error) {
+	var span, stride uint64
+	switch {
+	case step > 0 && end > start:
+		span, stride = uint64(end)-uint64(start), uint64(step)
+	case step < 0 && end < start:
+		span, stride = uint64(start)-uint64(end), uint64(-(step+1))+1
+	default:
+		return 0, nil
+	}
+	count := (span-1)/stride + 1
+	if count > math.MaxInt32 {
+		if MaxLoopIterations > 0 {
+			return 0, fmt.Errorf("range: %w: %d > %d", LoopMaxIterationsError, count, MaxLoopIterations)
+		}
+		return 0, fmt.Errorf("%w: %d", RangeTooLargeError, count)
+	}
+	return int(count), nil
+}
+
+func init() {
+	primitive_services.RegisterPrimitive(&Range{})
+}
@@ -0,0 +1,104 @@
+package primitives
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRange_Execute(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []interface{}
+		want    interface{}
+		wantErr error
+	}{
+		{
+			name: "end only",
+			args: []interface{}{int64(3)},
+			want: []interface{}{int64(0), int64(1), int64(2)},
+		},
+		{
+			name: "start and end",
+			args: []interface{}{int64(2), int64(5)},
+			want: []interface{}{int64(2), int64(3), int64(4)},
+		},
+		{
+			name: "step",
+			args: []interface{}{int64(0), int64(10), int64(4)},
+			want: []interface{}{int64(0), int64(4), int64(8)},
+		},
+		{
+			name: "negative step",
+			args: []interface{}{int64(3), int64(0), int64(-1)},
+			want: []interface{}{int64(3), int64(2), int64(1)},
+		},
+		{
+			name: "empty",
+			args: []interface{}{int64(5), int64(5)},
+			want: []interface{}{},
+		},
+		{
+			name: "wrong direction is empty",
+			args: []interface{}{int64(0), int64(5), int64(-1)},
+			want: []interface{}{},
+		},
+		{
+			name:    "zero step",
+			args:    []interface{}{int64(0), int64(5), int64(0)},
+			wantErr: RangeZeroStepError,
+		},
+		{
+			name:    "no arguments",
+			args:    []interface{}{},
+			wantErr: RangeInvalidArgumentsError,
+		},
+		{
+			name:    "too large",
+			args:    []interface{}{int64(MaxLoopIterations + 1)},
+			wantErr: LoopMaxIterationsError,
+		},
+		{
+			name: "span overflows upwards",
+			args: []interface{}{int64(0), int64(math.MaxInt64), int64(1) << 62},
+			want: []interface{}{int64(0), int64(1) << 62},
+		},
+		{
+			name:    "huge span upwards",
+			args:    []interface{}{int64(math.MinInt64), int64(math.MaxInt64), int64(1)},
+			wantErr: LoopMaxIterationsError,
+		},
+		{
+			name: "span overflows downwards",
+			args: []interface{}{int64(0), int64(math.MinInt64), int64(math.MinInt64)},
+			want: []interface{}{int64(0)},
+		},
+		{
+			name:    "huge span downwards",
+			args:    []interface{}{int64(math.MaxInt64), int64(math.MinInt64), int64(-1)},
+			wantErr: LoopMaxIterationsError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := (&Range{}).Execute(tt.args)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	_, err := (&Range{}).Execute([]interface{}{"many"})
+	assert.Error(t, err)
+
+	saved := MaxLoopIterations
+	MaxLoopIterations = 0
+	defer func() { MaxLoopIterations = saved }()
+	_, err = (&Range{}).Execute([]interface{}{int64(0), int64(math.MaxInt64), int64(2)})
+	assert.ErrorIs(t, err, RangeTooLargeError)
+}
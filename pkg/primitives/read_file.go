@@ -0,0 +1,70 @@
+package primitives
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"unicode/utf8"
+
+	"github.com/hyperifyio/gnd/pkg/parsers"
+	"github.com/hyperifyio/gnd/pkg/primitive_services"
+	"github.com/hyperifyio/gnd/pkg/primitive_types"
+)
+
+var (
+	ReadFileRequiresArgumentsError = errors.New("read-file: requires a path and an optional mode")
+	ReadFileInvalidModeError       = errors.New("read-file: mode must be text or bytes")
+	ReadFileNotTextError           = errors.New("read-file: file is not valid UTF-8 text, use the bytes mode")
+)
+
+// ReadFile represents the read-file primitive
+type ReadFile struct{}
+
+var _ primitive_types.Primitive = &ReadFile{}
+var _ primitive_types.BlockSuccessResultHandler = &ReadFile{}
+
+func (p *ReadFile) Name() string {
+	return "/gnd/read-file"
+}
+
+// Execute validates the arguments and returns a FileRequest for the
+// interpreter to handle
+func (p *ReadFile) Execute(args []interface{}) (interface{}, error) {
+	if len(args) < 1 || len(args) > 2 {
+		return nil, ReadFileRequiresArgumentsError
+	}
+	if len(args) == 2 && args[1] != "text" && args[1] != "bytes" {
+		return nil, ReadFileInvalidModeError
+	}
+	return NewFileRequest("read-file", args)
+}
+
+// HandleBlockSuccessResult reads the file as a string, or as []byte in the
+// bytes mode
+func (p *ReadFile) HandleBlockSuccessResult(result interface{}, i primitive_types.Interpreter, destination *parsers.PropertyRef, _ []*parsers.Instruction) (interface{}, error) {
+	request, ok := GetFileRequest(result)
+	if !ok {
+		return result, nil
+	}
+	path, err := ResolveFilePath(i.GetScriptDir(), request.Path)
+	if err != nil {
+		return nil, fmt.Errorf("read-file: %w", err)
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read-file: %w", err)
+	}
+
+	var value interface{} = content
+	if len(request.Args) == 0 || request.Args[0] == "text" {
+		if !utf8.Valid(content) {
+			return nil, fmt.Errorf("%w: %s", ReadFileNotTextError, request.Path)
+		}
+		value = string(content)
+	}
+	return HandleRoutineResult(i, destination, value)
+}
+
+func init() {
+	primitive_services.RegisterPrimitive(&ReadFile{})
+}
@@ -0,0 +1,36 @@
+package primitives_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hyperifyio/gnd/pkg/primitives"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadFile(t *testing.T) {
+	dir := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "note.txt"), []byte("hello\n"), 0644))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "blob.bin"), []byte{0xff, 0x00}, 0644))
+
+	got, err := runBlockIn(t, dir, nil, `$result read-file "note.txt"`)
+	assert.NoError(t, err)
+	assert.Equal(t, "hello\n", got)
+
+	got, err = runBlockIn(t, dir, nil, `$result read-file "blob.bin" bytes`)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0xff, 0x00}, got)
+
+	_, err = runBlockIn(t, dir, nil, `$result read-file "blob.bin"`)
+	assert.ErrorIs(t, err, primitives.ReadFileNotTextError)
+
+	_, err = runBlockIn(t, dir, nil, `$result read-file "missing.txt"`)
+	assert.ErrorIs(t, err, os.ErrNotExist)
+
+	_, err = runBlockIn(t, dir, nil, `$result read-file "../note.txt"`)
+	assert.ErrorIs(t, err, primitives.FileOutsideRootsError)
+
+	_, err = runBlockIn(t, dir, nil, `$result read-file "note.txt" lines`)
+	assert.ErrorIs(t, err, primitives.ReadFileInvalidModeError)
+}
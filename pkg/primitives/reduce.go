@@ -0,0 +1,63 @@
+package primitives
+
+import (
+	"errors"
+
+	"github.com/hyperifyio/gnd/pkg/parsers"
+	"github.com/hyperifyio/gnd/pkg/primitive_services"
+	"github.com/hyperifyio/gnd/pkg/primitive_types"
+)
+
+var ReduceEmptyListError = errors.New("reduce: empty list requires an initial value")
+
+// Reduce represents the reduce primitive
+type Reduce struct{}
+
+var _ primitive_types.Primitive = &Reduce{}
+var _ primitive_types.BlockSuccessResultHandler = &Reduce{}
+
+func (p *Reduce) Name() string {
+	return "/gnd/reduce"
+}
+
+// Execute validates the arguments and returns a CollectionResult for the
+// interpreter to handle
+func (p *Reduce) Execute(args []interface{}) (interface{}, error) {
+	return ParseCollectionArgs("reduce", args, 1)
+}
+
+// HandleBlockSuccessResult folds the list: the routine runs for each element
+// with "_" set to [ accumulator element ], and its result becomes the next
+// accumulator. Without an initial value the first element is the initial
+// accumulator.
+func (p *Reduce) HandleBlockSuccessResult(result interface{}, i primitive_types.Interpreter, destination *parsers.PropertyRef, _ []*parsers.Instruction) (interface{}, error) {
+	c, ok := GetCollectionResult(result)
+	if !ok {
+		return result, nil
+	}
+
+	list := c.List
+	var acc interface{}
+	if len(c.Args) > 0 {
+		acc = c.Args[0]
+	} else {
+		if len(list) == 0 {
+			return nil, ReduceEmptyListError
+		}
+		acc = list[0]
+		list = list[1:]
+	}
+
+	for _, item := range list {
+		var err error
+		acc, err = RunRoutine(i, p.Name(), c.Routine, []interface{}{acc, item})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return HandleRoutineResult(i, destination, acc)
+}
+
+func init() {
+	primitive_services.RegisterPrimitive(&Reduce{})
+}
@@ -0,0 +1,30 @@
+package primitives_test
+
+import (
+	"testing"
+
+	"github.com/hyperifyio/gnd/pkg/primitives"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReduce(t *testing.T) {
+	slots := map[string]interface{}{
+		"join": parseRoutine(t, "concat *_\n"),
+		"list": []interface{}{"a", "b", "c"},
+	}
+
+	got, err := runBlock(t, slots, `$result reduce $join $list`)
+	assert.NoError(t, err)
+	assert.Equal(t, "abc", got)
+
+	got, err = runBlock(t, slots, `$result reduce $join $list ">"`)
+	assert.NoError(t, err)
+	assert.Equal(t, ">abc", got)
+
+	got, err = runBlock(t, slots, `$result reduce $join [] "empty"`)
+	assert.NoError(t, err)
+	assert.Equal(t, "empty", got)
+
+	_, err = runBlock(t, slots, `$result reduce $join []`)
+	assert.ErrorIs(t, err, primitives.ReduceEmptyListError)
+}
@@ -0,0 +1,47 @@
+package primitives
+
+import (
+	"errors"
+	"regexp"
+
+	"github.com/hyperifyio/gnd/pkg/parsers"
+	"github.com/hyperifyio/gnd/pkg/primitive_services"
+	"github.com/hyperifyio/gnd/pkg/primitive_types"
+)
+
+var RegexMatchRequiresArgumentsError = errors.New("regex-match: requires a string and a pattern")
+
+// RegexMatch represents the regex-match primitive
+type RegexMatch struct{}
+
+var _ primitive_types.Primitive = &RegexMatch{}
+var _ primitive_types.BlockSuccessResultHandler = &RegexMatch{}
+
+func (p *RegexMatch) Name() string {
+	return "/gnd/regex-match"
+}
+
+// Execute validates the arguments and returns a RegexRequest for the
+// interpreter to handle
+func (p *RegexMatch) Execute(args []interface{}) (interface{}, error) {
+	if len(args) != 2 {
+		return nil, RegexMatchRequiresArgumentsError
+	}
+	return NewRegexRequest("regex-match", args, func(re *regexp.Regexp, subject string, _ []interface{}) (interface{}, error) {
+		submatches := re.FindStringSubmatch(subject)
+		if submatches == nil {
+			return nil, nil
+		}
+		return regexGroups(re, submatches), nil
+	})
+}
+
+// HandleBlockSuccessResult returns the groups of the first match, or nil if
+// the pattern does not match
+func (p *RegexMatch) HandleBlockSuccessResult(result interface{}, i primitive_types.Interpreter, destination *parsers.PropertyRef, _ []*parsers.Instruction) (interface{}, error) {
+	return handleRegexRequest(result, i, destination)
+}
+
+func init() {
+	primitive_services.RegisterPrimitive(&RegexMatch{})
+}
@@ -0,0 +1,46 @@
+package primitives
+
+import (
+	"errors"
+	"regexp"
+
+	"github.com/hyperifyio/gnd/pkg/parsers"
+	"github.com/hyperifyio/gnd/pkg/primitive_services"
+	"github.com/hyperifyio/gnd/pkg/primitive_types"
+)
+
+var RegexMatchAllRequiresArgumentsError = errors.New("regex-match-all: requires a string and a pattern")
+
+// RegexMatchAll represents the regex-match-all primitive
+type RegexMatchAll struct{}
+
+var _ primitive_types.Primitive = &RegexMatchAll{}
+var _ primitive_types.BlockSuccessResultHandler = &RegexMatchAll{}
+
+func (p *RegexMatchAll) Name() string {
+	return "/gnd/regex-match-all"
+}
+
+// Execute validates the arguments and returns a RegexRequest for the
+// interpreter to handle
+func (p *RegexMatchAll) Execute(args []interface{}) (interface{}, error) {
+	if len(args) != 2 {
+		return nil, RegexMatchAllRequiresArgumentsError
+	}
+	return NewRegexRequest("regex-match-all", args, func(re *regexp.Regexp, subject string, _ []interface{}) (interface{}, error) {
+		matches := []interface{}{}
+		for _, submatches := range re.FindAllStringSubmatch(subject, -1) {
+			matches = append(matches, regexGroups(re, submatches))
+		}
+		return matches, nil
+	})
+}
+
+// HandleBlockSuccessResult returns the groups of every non-overlapping match
+func (p *RegexMatchAll) HandleBlockSuccessResult(result interface{}, i primitive_types.Interpreter, destination *parsers.PropertyRef, _ []*parsers.Instruction) (interface{}, error) {
+	return handleRegexRequest(result, i, destination)
+}
+
+func init() {
+	primitive_services.RegisterPrimitive(&RegexMatchAll{})
+}
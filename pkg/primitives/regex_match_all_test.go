@@ -0,0 +1,31 @@
+package primitives_test
+
+import (
+	"testing"
+
+	"github.com/hyperifyio/gnd/pkg/primitives"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegexMatchAll(t *testing.T) {
+	got, err := runBlock(t, nil, `$result regex-match-all "a=1, b=22" "([a-z])=([0-9]+)"`)
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{
+		[]interface{}{"a=1", "a", "1"},
+		[]interface{}{"b=22", "b", "22"},
+	}, got)
+
+	got, err = runBlock(t, nil, `$result regex-match-all "a=1, b=22" "(?P<key>[a-z])=(?P<value>[0-9]+)"`)
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"key": "a", "value": "1"},
+		map[string]interface{}{"key": "b", "value": "22"},
+	}, got)
+
+	got, err = runBlock(t, nil, `$result regex-match-all "abc" "[0-9]"`)
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{}, got)
+
+	_, err = runBlock(t, nil, `$result regex-match-all "abc" "[0-9"`)
+	assert.ErrorIs(t, err, primitives.RegexInvalidError)
+}
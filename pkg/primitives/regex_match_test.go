@@ -0,0 +1,56 @@
+package primitives_test
+
+import (
+	"testing"
+
+	"github.com/hyperifyio/gnd/pkg/primitives"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegexMatch(t *testing.T) {
+	tests := []struct {
+		name   string
+		source string
+		want   interface{}
+	}{
+		{
+			name:   "groups as an array",
+			source: `$result regex-match "Score: 8/10" "([0-9]+)/([0-9]+)"`,
+			want:   []interface{}{"8/10", "8", "10"},
+		},
+		{
+			name:   "named groups as a map",
+			source: `$result regex-match "Verdict: PASS" "(?i)verdict:\\s*(?P<verdict>pass|fail)"`,
+			want:   map[string]interface{}{"verdict": "PASS"},
+		},
+		{
+			name:   "first match only",
+			source: `$result regex-match "a1 b2" "[a-z][0-9]"`,
+			want:   []interface{}{"a1"},
+		},
+		{
+			name:   "unmatched group is empty",
+			source: `$result regex-match "ab" "a(x)?b"`,
+			want:   []interface{}{"ab", ""},
+		},
+		{
+			name:   "no match",
+			source: `$result regex-match "abc" "[0-9]"`,
+			want:   nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := runBlock(t, nil, tt.source)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	_, err := runBlock(t, nil, `$result regex-match "abc" "("`)
+	assert.ErrorIs(t, err, primitives.RegexInvalidError)
+	_, err = runBlock(t, nil, `$result regex-match 1 "[0-9]"`)
+	assert.ErrorIs(t, err, primitives.StringArgumentInvalidError)
+	_, err = runBlock(t, nil, `$result regex-match "abc"`)
+	assert.ErrorIs(t, err, primitives.RegexMatchRequiresArgumentsError)
+}
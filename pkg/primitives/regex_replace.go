@@ -0,0 +1,46 @@
+package primitives
+
+import (
+	"errors"
+	"regexp"
+
+	"github.com/hyperifyio/gnd/pkg/parsers"
+	"github.com/hyperifyio/gnd/pkg/primitive_services"
+	"github.com/hyperifyio/gnd/pkg/primitive_types"
+)
+
+var RegexReplaceRequiresArgumentsError = errors.New("regex-replace: requires a string, a pattern and a replacement")
+
+// RegexReplace represents the regex-replace primitive
+type RegexReplace struct{}
+
+var _ primitive_types.Primitive = &RegexReplace{}
+var _ primitive_types.BlockSuccessResultHandler = &RegexReplace{}
+
+func (p *RegexReplace) Name() string {
+	return "/gnd/regex-replace"
+}
+
+// Execute validates the arguments and returns a RegexRequest for the
+// interpreter to handle
+func (p *RegexReplace) Execute(args []interface{}) (interface{}, error) {
+	if len(args) != 3 {
+		return nil, RegexReplaceRequiresArgumentsError
+	}
+	if _, err := stringArg("regex-replace", args, 2); err != nil {
+		return nil, err
+	}
+	return NewRegexRequest("regex-replace", args, func(re *regexp.Regexp, subject string, rest []interface{}) (interface{}, error) {
+		return re.ReplaceAllString(subject, rest[0].(string)), nil
+	})
+}
+
+// HandleBlockSuccessResult replaces every match. The replacement may refer
+// to groups as $1 or ${name}.
+func (p *RegexReplace) HandleBlockSuccessResult(result interface{}, i primitive_types.Interpreter, destination *parsers.PropertyRef, _ []*parsers.Instruction) (interface{}, error) {
+	return handleRegexRequest(result, i, destination)
+}
+
+func init() {
+	primitive_services.RegisterPrimitive(&RegexReplace{})
+}
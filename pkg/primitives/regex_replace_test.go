@@ -0,0 +1,49 @@
+package primitives_test
+
+import (
+	"testing"
+
+	"github.com/hyperifyio/gnd/pkg/primitives"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegexReplace(t *testing.T) {
+	tests := []struct {
+		name   string
+		source string
+		want   interface{}
+	}{
+		{
+			name:   "every match",
+			source: `$result regex-replace "a  b   c" " +" " "`,
+			want:   "a b c",
+		},
+		{
+			name:   "numbered groups",
+			source: `$result regex-replace "2024-05-17" "([0-9]+)-([0-9]+)-([0-9]+)" "${3}.${2}.${1}"`,
+			want:   "17.05.2024",
+		},
+		{
+			name:   "named groups",
+			source: `$result regex-replace "key=value" "(?P<k>[a-z]+)=(?P<v>[a-z]+)" "${v}=${k}"`,
+			want:   "value=key",
+		},
+		{
+			name:   "no match",
+			source: `$result regex-replace "abc" "[0-9]" "x"`,
+			want:   "abc",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := runBlock(t, nil, tt.source)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	_, err := runBlock(t, nil, `$result regex-replace "abc" "b" 1`)
+	assert.ErrorIs(t, err, primitives.StringArgumentInvalidError)
+	_, err = runBlock(t, nil, `$result regex-replace "abc" "b"`)
+	assert.ErrorIs(t, err, primitives.RegexReplaceRequiresArgumentsError)
+}
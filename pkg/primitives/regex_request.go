@@ -0,0 +1,91 @@
+package primitives
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+
+	"github.com/hyperifyio/gnd/pkg/parsers"
+	"github.com/hyperifyio/gnd/pkg/primitive_types"
+)
+
+var RegexInvalidError = errors.New("invalid regular expression")
+
+// RegexRequest is returned by the Execute method of a regular expression
+// primitive. The pattern is compiled by HandleBlockSuccessResult through the
+// interpreter, which caches compiled patterns.
+type RegexRequest struct {
+	Name    string
+	Subject string
+	Pattern string
+	// Args are the arguments after the pattern
+	Args []interface{}
+	// Apply computes the result of the primitive
+	Apply func(re *regexp.Regexp, subject string, args []interface{}) (interface{}, error)
+}
+
+// String returns a string representation of the RegexRequest
+func (r *RegexRequest) String() string {
+	return fmt.Sprintf("RegexRequest{%s: %q, subject: %q}", r.Name, r.Pattern, r.Subject)
+}
+
+// NewRegexRequest validates the subject and pattern arguments of a regular
+// expression primitive
+func NewRegexRequest(name string, args []interface{}, apply func(*regexp.Regexp, string, []interface{}) (interface{}, error)) (*RegexRequest, error) {
+	subject, err := stringArg(name, args, 0)
+	if err != nil {
+		return nil, err
+	}
+	pattern, err := stringArg(name, args, 1)
+	if err != nil {
+		return nil, err
+	}
+	return &RegexRequest{Name: name, Subject: subject, Pattern: pattern, Args: args[2:], Apply: apply}, nil
+}
+
+// handleRegexRequest compiles the pattern of a RegexRequest and stores the
+// result of applying it in the destination
+func handleRegexRequest(result interface{}, i primitive_types.Interpreter, destination *parsers.PropertyRef) (interface{}, error) {
+	request, ok := result.(*RegexRequest)
+	if !ok {
+		return result, nil
+	}
+	re, err := i.CompileRegexp(request.Pattern)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w: %w", request.Name, RegexInvalidError, err)
+	}
+	value, err := request.Apply(re, request.Subject, request.Args)
+	if err != nil {
+		return nil, err
+	}
+	return HandleRoutineResult(i, destination, value)
+}
+
+// regexGroups returns the submatches of a match. Without named groups the
+// result is an array of the whole match followed by every group. With named
+// groups it is a map from group name to submatch. Groups that did not take
+// part in the match are empty strings.
+func regexGroups(re *regexp.Regexp, submatches []string) interface{} {
+	names := re.SubexpNames()
+	named := false
+	for _, name := range names {
+		if name != "" {
+			named = true
+			break
+		}
+	}
+	if !named {
+		groups := make([]interface{}, len(submatches))
+		for index, submatch := range submatches {
+			groups[index] = submatch
+		}
+		return groups
+	}
+	groups := make(map[string]interface{})
+	for index, name := range names {
+		if name != "" {
+			groups[name] = submatches[index]
+		}
+	}
+	return groups
+}
@@ -0,0 +1,47 @@
+package primitives
+
+import (
+	"errors"
+	"regexp"
+
+	"github.com/hyperifyio/gnd/pkg/parsers"
+	"github.com/hyperifyio/gnd/pkg/primitive_services"
+	"github.com/hyperifyio/gnd/pkg/primitive_types"
+)
+
+var RegexSplitRequiresArgumentsError = errors.New("regex-split: requires a string and a pattern")
+
+// RegexSplit represents the regex-split primitive
+type RegexSplit struct{}
+
+var _ primitive_types.Primitive = &RegexSplit{}
+var _ primitive_types.BlockSuccessResultHandler = &RegexSplit{}
+
+func (p *RegexSplit) Name() string {
+	return "/gnd/regex-split"
+}
+
+// Execute validates the arguments and returns a RegexRequest for the
+// interpreter to handle
+func (p *RegexSplit) Execute(args []interface{}) (interface{}, error) {
+	if len(args) != 2 {
+		return nil, RegexSplitRequiresArgumentsError
+	}
+	return NewRegexRequest("regex-split", args, func(re *regexp.Regexp, subject string, _ []interface{}) (interface{}, error) {
+		parts := re.Split(subject, -1)
+		result := make([]interface{}, len(parts))
+		for index, part := range parts {
+			result[index] = part
+		}
+		return result, nil
+	})
+}
+
+// HandleBlockSuccessResult splits the string at every match
+func (p *RegexSplit) HandleBlockSuccessResult(result interface{}, i primitive_types.Interpreter, destination *parsers.PropertyRef, _ []*parsers.Instruction) (interface{}, error) {
+	return handleRegexRequest(result, i, destination)
+}
+
+func init() {
+	primitive_services.RegisterPrimitive(&RegexSplit{})
+}
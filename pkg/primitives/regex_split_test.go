@@ -0,0 +1,21 @@
+package primitives_test
+
+import (
+	"testing"
+
+	"github.com/hyperifyio/gnd/pkg/primitives"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegexSplit(t *testing.T) {
+	got, err := runBlock(t, nil, `$result regex-split "a, b;c ,d" "\\s*[,;]\\s*"`)
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{"a", "b", "c", "d"}, got)
+
+	got, err = runBlock(t, nil, `$result regex-split "abc" "[0-9]"`)
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{"abc"}, got)
+
+	_, err = runBlock(t, nil, `$result regex-split "abc"`)
+	assert.ErrorIs(t, err, primitives.RegexSplitRequiresArgumentsError)
+}
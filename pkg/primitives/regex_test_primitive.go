@@ -0,0 +1,43 @@
+package primitives
+
+import (
+	"errors"
+	"regexp"
+
+	"github.com/hyperifyio/gnd/pkg/parsers"
+	"github.com/hyperifyio/gnd/pkg/primitive_services"
+	"github.com/hyperifyio/gnd/pkg/primitive_types"
+)
+
+var RegexTestRequiresArgumentsError = errors.New("regex-test: requires a string and a pattern")
+
+// RegexTest represents the regex-test primitive
+type RegexTest struct{}
+
+var _ primitive_types.Primitive = &RegexTest{}
+var _ primitive_types.BlockSuccessResultHandler = &RegexTest{}
+
+func (p *RegexTest) Name() string {
+	return "/gnd/regex-test"
+}
+
+// Execute validates the arguments and returns a RegexRequest for the
+// interpreter to handle
+func (p *RegexTest) Execute(args []interface{}) (interface{}, error) {
+	if len(args) != 2 {
+		return nil, RegexTestRequiresArgumentsError
+	}
+	return NewRegexRequest("regex-test", args, func(re *regexp.Regexp, subject string, _ []interface{}) (interface{}, error) {
+		return re.MatchString(subject), nil
+	})
+}
+
+// HandleBlockSuccessResult returns true if the pattern matches anywhere in
+// the string
+func (p *RegexTest) HandleBlockSuccessResult(result interface{}, i primitive_types.Interpreter, destination *parsers.PropertyRef, _ []*parsers.Instruction) (interface{}, error) {
+	return handleRegexRequest(result, i, destination)
+}
+
+func init() {
+	primitive_services.RegisterPrimitive(&RegexTest{})
+}
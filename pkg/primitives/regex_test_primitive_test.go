@@ -0,0 +1,23 @@
+package primitives_test
+
+import (
+	"testing"
+
+	"github.com/hyperifyio/gnd/pkg/primitives"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegexTest(t *testing.T) {
+	got, err := runBlock(t, nil, `$result regex-test "The answer is YES." "\\b(YES|NO)\\b"`)
+	assert.NoError(t, err)
+	assert.Equal(t, true, got)
+
+	got, err = runBlock(t, nil, `$result regex-test "yesterday" "^(yes|no)$"`)
+	assert.NoError(t, err)
+	assert.Equal(t, false, got)
+
+	_, err = runBlock(t, nil, `$result regex-test "abc" "a{2,1}"`)
+	assert.ErrorIs(t, err, primitives.RegexInvalidError)
+	_, err = runBlock(t, nil, `$result regex-test "abc"`)
+	assert.ErrorIs(t, err, primitives.RegexTestRequiresArgumentsError)
+}
@@ -0,0 +1,75 @@
+package primitives
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/hyperifyio/gnd/pkg/parsers"
+	"github.com/hyperifyio/gnd/pkg/primitive_services"
+	"github.com/hyperifyio/gnd/pkg/primitive_types"
+)
+
+var RepeatRequiresArgumentsError = errors.New("repeat: requires a routine and a count")
+var RepeatRoutineInvalidError = errors.New("repeat: routine must be an instruction array or instruction")
+var RepeatNegativeCountError = errors.New("repeat: count must not be negative")
+
+// Repeat represents the repeat primitive
+type Repeat struct{}
+
+var _ primitive_types.Primitive = &Repeat{}
+var _ primitive_types.BlockSuccessResultHandler = &Repeat{}
+
+func (r *Repeat) Name() string {
+	return "/gnd/repeat"
+}
+
+// Execute validates the arguments and returns a RepeatResult for the
+// interpreter to handle
+func (r *Repeat) Execute(args []interface{}) (interface{}, error) {
+	if len(args) < 2 {
+		return nil, RepeatRequiresArgumentsError
+	}
+	routine, ok := ToRoutine(args[0])
+	if !ok {
+		return nil, RepeatRoutineInvalidError
+	}
+	count, err := parsers.ParseInt(args[1])
+	if err != nil {
+		return nil, fmt.Errorf("repeat: %w", err)
+	}
+	if count < 0 {
+		return nil, RepeatNegativeCountError
+	}
+	if err := checkLoopIterations("repeat", count); err != nil {
+		return nil, err
+	}
+	return NewRepeatResult(routine, count, args[2:]), nil
+}
+
+// HandleBlockSuccessResult runs the routine Count times, each time in a new
+// child interpreter, and collects the results into an array
+func (r *Repeat) HandleBlockSuccessResult(result interface{}, i primitive_types.Interpreter, destination *parsers.PropertyRef, _ []*parsers.Instruction) (interface{}, error) {
+	repeatResult, ok := GetRepeatResult(result)
+	if !ok {
+		return result, nil
+	}
+
+	results := make([]interface{}, 0, repeatResult.Count)
+	for idx := 0; idx < repeatResult.Count; idx++ {
+		i.LogDebug("[/gnd/repeat]: HandleBlockSuccessResult: iteration %d/%d", idx+1, repeatResult.Count)
+		res, err := HandleExecResult(i, NewExecResult(repeatResult.Routine, repeatResult.Args))
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, res)
+	}
+
+	if err := i.SetSlot(destination.Name, results); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+func init() {
+	primitive_services.RegisterPrimitive(&Repeat{})
+}
@@ -0,0 +1,37 @@
+package primitives
+
+import (
+	"fmt"
+
+	"github.com/hyperifyio/gnd/pkg/parsers"
+)
+
+// RepeatResult represents a request to execute a routine several times
+type RepeatResult struct {
+	// Routine is the instruction array to execute
+	Routine []*parsers.Instruction
+	// Count is the number of times to execute the routine
+	Count int
+	// Args are the arguments to pass to the routine on every iteration
+	Args []interface{}
+}
+
+// String returns a string representation of the RepeatResult
+func (r *RepeatResult) String() string {
+	return fmt.Sprintf("RepeatResult{routine: %v, count: %d, args: %v}", r.Routine, r.Count, r.Args)
+}
+
+// NewRepeatResult creates a new RepeatResult
+func NewRepeatResult(routine []*parsers.Instruction, count int, args []interface{}) *RepeatResult {
+	return &RepeatResult{
+		Routine: routine,
+		Count:   count,
+		Args:    args,
+	}
+}
+
+// GetRepeatResult extracts the RepeatResult from a value if it is one
+func GetRepeatResult(v interface{}) (*RepeatResult, bool) {
+	result, ok := v.(*RepeatResult)
+	return result, ok
+}
@@ -0,0 +1,47 @@
+package primitives
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hyperifyio/gnd/pkg/primitive_services"
+	"github.com/hyperifyio/gnd/pkg/primitive_types"
+)
+
+var (
+	RepeatStringRequiresArgumentsError = errors.New("repeat-string: requires a string and a count")
+	RepeatStringCountInvalidError      = errors.New("repeat-string: count must not be negative")
+)
+
+// RepeatString represents the repeat-string primitive
+type RepeatString struct{}
+
+var _ primitive_types.Primitive = &RepeatString{}
+
+func (p *RepeatString) Name() string {
+	return "/gnd/repeat-string"
+}
+
+// Execute returns the string repeated count times
+func (p *RepeatString) Execute(args []interface{}) (interface{}, error) {
+	if len(args) != 2 {
+		return nil, RepeatStringRequiresArgumentsError
+	}
+	s, err := stringArg("repeat-string", args, 0)
+	if err != nil {
+		return nil, err
+	}
+	count, err := intArg("repeat-string", args, 1)
+	if err != nil {
+		return nil, err
+	}
+	if count < 0 {
+		return nil, fmt.Errorf("%w: %d", RepeatStringCountInvalidError, count)
+	}
+	return strings.Repeat(s, count), nil
+}
+
+func init() {
+	primitive_services.RegisterPrimitive(&RepeatString{})
+}
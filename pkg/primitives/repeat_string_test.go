@@ -0,0 +1,22 @@
+package primitives
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRepeatString(t *testing.T) {
+	got, err := (&RepeatString{}).Execute([]interface{}{"ab", int64(3)})
+	assert.NoError(t, err)
+	assert.Equal(t, "ababab", got)
+
+	got, err = (&RepeatString{}).Execute([]interface{}{"ab", int64(0)})
+	assert.NoError(t, err)
+	assert.Equal(t, "", got)
+
+	_, err = (&RepeatString{}).Execute([]interface{}{"ab", int64(-1)})
+	assert.ErrorIs(t, err, RepeatStringCountInvalidError)
+	_, err = (&RepeatString{}).Execute([]interface{}{"ab"})
+	assert.ErrorIs(t, err, RepeatStringRequiresArgumentsError)
+}
@@ -0,0 +1,34 @@
+package primitives_test
+
+import (
+	"testing"
+
+	"github.com/hyperifyio/gnd/pkg/primitives"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRepeat(t *testing.T) {
+	slots := map[string]interface{}{"r": concatRoutine()}
+
+	got, err := runBlock(t, slots, `$result repeat $r 3 "a" "b"`)
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{"ab", "ab", "ab"}, got)
+
+	got, err = runBlock(t, slots, `$result repeat $r 0`)
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{}, got)
+
+	_, err = runBlock(t, slots, `$result repeat $r -1`)
+	assert.ErrorIs(t, err, primitives.RepeatNegativeCountError)
+
+	_, err = runBlock(t, slots, `$result repeat "r" 1`)
+	assert.ErrorIs(t, err, primitives.RepeatRoutineInvalidError)
+
+	_, err = runBlock(t, slots, `$result repeat $r`)
+	assert.ErrorIs(t, err, primitives.RepeatRequiresArgumentsError)
+
+	defer func(max int) { primitives.MaxLoopIterations = max }(primitives.MaxLoopIterations)
+	primitives.MaxLoopIterations = 2
+	_, err = runBlock(t, slots, `$result repeat $r 3`)
+	assert.ErrorIs(t, err, primitives.LoopMaxIterationsError)
+}
@@ -0,0 +1,46 @@
+package primitives
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hyperifyio/gnd/pkg/primitive_services"
+	"github.com/hyperifyio/gnd/pkg/primitive_types"
+)
+
+var ReplaceRequiresArgumentsError = errors.New("replace: requires a string, a search string and a replacement")
+
+// Replace represents the replace primitive
+type Replace struct{}
+
+var _ primitive_types.Primitive = &Replace{}
+
+func (p *Replace) Name() string {
+	return "/gnd/replace"
+}
+
+// Execute replaces the first occurrence of the search string
+func (p *Replace) Execute(args []interface{}) (interface{}, error) {
+	if len(args) != 3 {
+		return nil, ReplaceRequiresArgumentsError
+	}
+	return replaceString("replace", args, 1)
+}
+
+// replaceString replaces the first n occurrences of args[1] in args[0] with
+// args[2], or all of them if n is negative
+func replaceString(name string, args []interface{}, n int) (interface{}, error) {
+	strs, err := stringArgs(name, args)
+	if err != nil {
+		return nil, err
+	}
+	if strs[1] == "" {
+		return nil, fmt.Errorf("%s: %w", name, StringSearchEmptyError)
+	}
+	return strings.Replace(strs[0], strs[1], strs[2], n), nil
+}
+
+func init() {
+	primitive_services.RegisterPrimitive(&Replace{})
+}
@@ -0,0 +1,31 @@
+package primitives
+
+import (
+	"errors"
+
+	"github.com/hyperifyio/gnd/pkg/primitive_services"
+	"github.com/hyperifyio/gnd/pkg/primitive_types"
+)
+
+var ReplaceAllRequiresArgumentsError = errors.New("replace-all: requires a string, a search string and a replacement")
+
+// ReplaceAll represents the replace-all primitive
+type ReplaceAll struct{}
+
+var _ primitive_types.Primitive = &ReplaceAll{}
+
+func (p *ReplaceAll) Name() string {
+	return "/gnd/replace-all"
+}
+
+// Execute replaces every non-overlapping occurrence of the search string
+func (p *ReplaceAll) Execute(args []interface{}) (interface{}, error) {
+	if len(args) != 3 {
+		return nil, ReplaceAllRequiresArgumentsError
+	}
+	return replaceString("replace-all", args, -1)
+}
+
+func init() {
+	primitive_services.RegisterPrimitive(&ReplaceAll{})
+}
@@ -0,0 +1,22 @@
+package primitives
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplaceAll(t *testing.T) {
+	got, err := (&ReplaceAll{}).Execute([]interface{}{"a-b-c", "-", "+"})
+	assert.NoError(t, err)
+	assert.Equal(t, "a+b+c", got)
+
+	got, err = (&ReplaceAll{}).Execute([]interface{}{"aaa", "aa", "b"})
+	assert.NoError(t, err)
+	assert.Equal(t, "ba", got)
+
+	_, err = (&ReplaceAll{}).Execute([]interface{}{"abc", "", "y"})
+	assert.ErrorIs(t, err, StringSearchEmptyError)
+	_, err = (&ReplaceAll{}).Execute([]interface{}{"abc"})
+	assert.ErrorIs(t, err, ReplaceAllRequiresArgumentsError)
+}
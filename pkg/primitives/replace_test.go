@@ -0,0 +1,24 @@
+package primitives
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplace(t *testing.T) {
+	got, err := (&Replace{}).Execute([]interface{}{"a-b-c", "-", "+"})
+	assert.NoError(t, err)
+	assert.Equal(t, "a+b-c", got)
+
+	got, err = (&Replace{}).Execute([]interface{}{"abc", "x", "y"})
+	assert.NoError(t, err)
+	assert.Equal(t, "abc", got)
+
+	_, err = (&Replace{}).Execute([]interface{}{"abc", "", "y"})
+	assert.ErrorIs(t, err, StringSearchEmptyError)
+	_, err = (&Replace{}).Execute([]interface{}{"abc", "a", int64(1)})
+	assert.ErrorIs(t, err, StringArgumentInvalidError)
+	_, err = (&Replace{}).Execute([]interface{}{"abc", "a"})
+	assert.ErrorIs(t, err, ReplaceRequiresArgumentsError)
+}
@@ -0,0 +1,50 @@
+package primitives
+
+import (
+	"github.com/hyperifyio/gnd/pkg/parsers"
+	"github.com/hyperifyio/gnd/pkg/primitive_types"
+)
+
+// ToRoutine returns v as an instruction array if it is a routine produced by
+// code or compile, or a single instruction
+func ToRoutine(v interface{}) ([]*parsers.Instruction, bool) {
+	switch r := v.(type) {
+	case []*parsers.Instruction:
+		return r, true
+	case *parsers.Instruction:
+		return []*parsers.Instruction{r}, true
+	default:
+		return nil, false
+	}
+}
+
+// HandleRoutineResult runs the routine of an ExecResult returned by a
+// primitive that dispatches to routines, and stores the final value in the
+// destination slot. Other results are stored as they are.
+func HandleRoutineResult(i primitive_types.Interpreter, destination *parsers.PropertyRef, result interface{}) (interface{}, error) {
+	if execResult, ok := GetExecResult(result); ok {
+		res, err := HandleExecResult(i, execResult)
+		if err != nil {
+			return nil, err
+		}
+		result = res
+	}
+	if destination != nil {
+		if err := i.SetSlot(destination.Name, result); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// RunRoutine executes routine in a new child interpreter whose "_" slot is
+// input, and returns the result of the routine
+func RunRoutine(i primitive_types.Interpreter, source string, routine []*parsers.Instruction, input interface{}) (interface{}, error) {
+	interpreter := i.NewInterpreterWithParent(
+		i.GetScriptDir(),
+		map[string]interface{}{
+			"_": input,
+		},
+	)
+	return interpreter.ExecuteInstructionBlock(source, input, routine)
+}
@@ -0,0 +1,217 @@
+package primitives
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"time"
+
+	"github.com/hyperifyio/gnd/pkg/parsers"
+	"github.com/hyperifyio/gnd/pkg/primitive_services"
+	"github.com/hyperifyio/gnd/pkg/primitive_types"
+	"github.com/hyperifyio/gnd/pkg/runtime_errors"
+)
+
+// RunAllowlist are the programs the run primitive may execute. A program
+// must be given in a script exactly as it is listed. When empty, run fails.
+var RunAllowlist []string
+
+var (
+	RunRequiresArgumentsError = errors.New("run: requires a program, an optional argument array and an optional options map")
+	RunProgramInvalidError    = errors.New("run: program must be a non-empty string")
+	RunNotAllowedError        = errors.New("run: program is not allowed")
+	RunArgumentsInvalidError  = errors.New("run: arguments must be an array of strings")
+	RunOptionsInvalidError    = errors.New("run: options must be a map")
+	RunOptionUnknownError     = errors.New("run: unknown option")
+	RunOptionInvalidError     = errors.New("run: invalid option")
+	RunTimeoutError           = errors.New("run: timed out")
+)
+
+// RunRequest is returned by the Execute method of the run primitive. The
+// program is started by HandleBlockSuccessResult, which knows the script
+// directory that a relative working directory is resolved against.
+type RunRequest struct {
+	Program string
+	Args    []string
+	// Stdin is written to the standard input of the program
+	Stdin []byte
+	// Env are added to the environment of gnd
+	Env []string
+	// Dir is the working directory as given in the script
+	Dir string
+	// Timeout is zero if the program may run indefinitely
+	Timeout time.Duration
+}
+
+// String returns a string representation of the RunRequest
+func (r *RunRequest) String() string {
+	return fmt.Sprintf("RunRequest{program: %q, args: %q}", r.Program, r.Args)
+}
+
+// Run represents the run primitive
+type Run struct{}
+
+var _ primitive_types.Primitive = &Run{}
+var _ primitive_types.BlockSuccessResultHandler = &Run{}
+
+func (p *Run) Name() string {
+	return "/gnd/run"
+}
+
+// Execute validates the arguments and returns a RunRequest for the
+// interpreter to handle
+func (p *Run) Execute(args []interface{}) (interface{}, error) {
+	if len(args) < 1 || len(args) > 3 {
+		return nil, RunRequiresArgumentsError
+	}
+	program, ok := args[0].(string)
+	if !ok || program == "" {
+		return nil, RunProgramInvalidError
+	}
+	if !isRunAllowed(program) {
+		return nil, fmt.Errorf("%w: %s", RunNotAllowedError, program)
+	}
+	request := &RunRequest{Program: program}
+
+	if len(args) >= 2 {
+		list, ok := args[1].([]interface{})
+		if !ok {
+			return nil, RunArgumentsInvalidError
+		}
+		for _, item := range list {
+			arg, ok := item.(string)
+			if !ok {
+				return nil, fmt.Errorf("%w: %v (%T)", RunArgumentsInvalidError, item, item)
+			}
+			request.Args = append(request.Args, arg)
+		}
+	}
+
+	if len(args) == 3 {
+		options, ok := args[2].(map[string]interface{})
+		if !ok {
+			return nil, RunOptionsInvalidError
+		}
+		if err := parseRunOptions(request, options); err != nil {
+			return nil, err
+		}
+	}
+	return request, nil
+}
+
+// parseRunOptions sets the stdin, env, dir and timeout options of request
+func parseRunOptions(request *RunRequest, options map[string]interface{}) error {
+	for _, key := range SortedKeys(options) {
+		value := options[key]
+		switch key {
+		case "stdin":
+			switch v := value.(type) {
+			case string:
+				request.Stdin = []byte(v)
+			case []byte:
+				request.Stdin = v
+			default:
+				return fmt.Errorf("%w: stdin must be a string or bytes", RunOptionInvalidError)
+			}
+		case "env":
+			env, ok := value.(map[string]interface{})
+			if !ok {
+				return fmt.Errorf("%w: env must be a map of strings", RunOptionInvalidError)
+			}
+			for _, name := range SortedKeys(env) {
+				s, ok := env[name].(string)
+				if !ok {
+					return fmt.Errorf("%w: env %s must be a string", RunOptionInvalidError, name)
+				}
+				request.Env = append(request.Env, name+"="+s)
+			}
+		case "dir":
+			dir, ok := value.(string)
+			if !ok || dir == "" {
+				return fmt.Errorf("%w: dir must be a non-empty string", RunOptionInvalidError)
+			}
+			request.Dir = dir
+		case "timeout":
+			n, err := ToNumber(value)
+			if err != nil || toFloat64(n) <= 0 {
+				return fmt.Errorf("%w: timeout must be a positive number of milliseconds", RunOptionInvalidError)
+			}
+			request.Timeout = time.Duration(toFloat64(n) * float64(time.Millisecond))
+		default:
+			return fmt.Errorf("%w: %s", RunOptionUnknownError, key)
+		}
+	}
+	return nil
+}
+
+// isRunAllowed returns true if program is in RunAllowlist
+func isRunAllowed(program string) bool {
+	for _, allowed := range RunAllowlist {
+		if program == allowed {
+			return true
+		}
+	}
+	return false
+}
+
+// HandleBlockSuccessResult runs the program and returns a map with its
+// stdout, stderr and exit-code. A non-zero exit code is not an error.
+func (p *Run) HandleBlockSuccessResult(result interface{}, i primitive_types.Interpreter, destination *parsers.PropertyRef, _ []*parsers.Instruction) (interface{}, error) {
+	request, ok := result.(*RunRequest)
+	if !ok {
+		return result, nil
+	}
+
+	// The program is killed if the task running it is cancelled
+	ctx := i.GetContext()
+	if request.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, request.Timeout)
+		defer cancel()
+	}
+
+	cmd := exec.CommandContext(ctx, request.Program, request.Args...)
+	cmd.Dir = i.GetScriptDir()
+	if request.Dir != "" {
+		cmd.Dir = request.Dir
+		if !filepath.IsAbs(request.Dir) {
+			cmd.Dir = filepath.Join(i.GetScriptDir(), request.Dir)
+		}
+	}
+	if len(request.Env) > 0 {
+		cmd.Env = append(os.Environ(), request.Env...)
+	}
+	cmd.Stdin = bytes.NewReader(request.Stdin)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	// Children that keep the output open must not block gnd after a timeout
+	cmd.WaitDelay = time.Second
+
+	err := cmd.Run()
+	if err := i.GetContext().Err(); err != nil {
+		return nil, runtime_errors.NewContextError(err)
+	}
+	if ctx.Err() == context.DeadlineExceeded {
+		message := fmt.Sprintf("%s after %s: %s", RunTimeoutError, request.Timeout, request.Program)
+		return nil, runtime_errors.NewErrorValue(runtime_errors.TimeoutErrorCode, message, nil, RunTimeoutError)
+	}
+	var exitErr *exec.ExitError
+	if err != nil && !errors.As(err, &exitErr) {
+		return nil, fmt.Errorf("run: %w", err)
+	}
+
+	return HandleRoutineResult(i, destination, map[string]interface{}{
+		"stdout":    stdout.String(),
+		"stderr":    stderr.String(),
+		"exit-code": int64(cmd.ProcessState.ExitCode()),
+	})
+}
+
+func init() {
+	primitive_services.RegisterPrimitive(&Run{})
+}
@@ -0,0 +1,103 @@
+package primitives_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hyperifyio/gnd/pkg/primitives"
+	"github.com/stretchr/testify/assert"
+)
+
+func allowRun(t *testing.T, programs ...string) {
+	saved := primitives.RunAllowlist
+	primitives.RunAllowlist = programs
+	t.Cleanup(func() { primitives.RunAllowlist = saved })
+}
+
+func TestRun(t *testing.T) {
+	dir := t.TempDir()
+	allowRun(t, "sh", "cat", "pwd")
+
+	got, err := runBlockIn(t, dir, nil, `$result run sh ["-c" "echo out; echo err >&2; exit 3"]`)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"stdout":    "out\n",
+		"stderr":    "err\n",
+		"exit-code": int64(3),
+	}, got)
+
+	got, err = runBlockIn(t, dir, nil, `$result run cat [] { stdin "hello" }`)
+	assert.NoError(t, err)
+	assert.Equal(t, "hello", got.(map[string]interface{})["stdout"])
+
+	got, err = runBlockIn(t, dir, nil, `$result run sh ["-c" "echo $GND_TEST_VALUE"] { env { GND_TEST_VALUE "a b" } }`)
+	assert.NoError(t, err)
+	assert.Equal(t, "a b\n", got.(map[string]interface{})["stdout"])
+
+	// Arguments are passed as they are, without a shell
+	got, err = runBlockIn(t, dir, nil, `$result run sh ["-c" "printf %s \"$0\"" "$HOME;ls"]`)
+	assert.NoError(t, err)
+	assert.Equal(t, "$HOME;ls", got.(map[string]interface{})["stdout"])
+}
+
+func TestRunDir(t *testing.T) {
+	allowRun(t, "pwd")
+	dir, err := filepath.EvalSymlinks(t.TempDir())
+	assert.NoError(t, err)
+	assert.NoError(t, os.Mkdir(filepath.Join(dir, "sub"), 0755))
+
+	got, err := runBlockIn(t, dir, nil, `$result run pwd`)
+	assert.NoError(t, err)
+	assert.Equal(t, dir+"\n", got.(map[string]interface{})["stdout"])
+
+	got, err = runBlockIn(t, dir, nil, `$result run pwd [] { dir sub }`)
+	assert.NoError(t, err)
+	assert.Equal(t, filepath.Join(dir, "sub")+"\n", got.(map[string]interface{})["stdout"])
+}
+
+func TestRunTimeout(t *testing.T) {
+	dir := t.TempDir()
+	allowRun(t, "sleep")
+	_, err := runBlockIn(t, dir, nil, `$result run sleep [5] { timeout 50 }`)
+	assert.ErrorIs(t, err, primitives.RunArgumentsInvalidError)
+
+	_, err = runBlockIn(t, dir, nil, `$result run sleep ["5"] { timeout 50 }`)
+	assert.ErrorIs(t, err, primitives.RunTimeoutError)
+}
+
+func TestRunErrors(t *testing.T) {
+	dir := t.TempDir()
+	allowRun(t, "sh", "gnd-missing-program")
+	tests := []struct {
+		name   string
+		source string
+		want   error
+	}{
+		{"too many arguments", `$result run sh [] {} x`, primitives.RunRequiresArgumentsError},
+		{"not allowed", `$result run ls`, primitives.RunNotAllowedError},
+		{"allowed by path only", `$result run /bin/sh`, primitives.RunNotAllowedError},
+		{"program not a string", `$result run [sh]`, primitives.RunProgramInvalidError},
+		{"arguments not an array", `$result run sh "-c"`, primitives.RunArgumentsInvalidError},
+		{"options not a map", `$result run sh [] "x"`, primitives.RunOptionsInvalidError},
+		{"unknown option", `$result run sh [] { shell "bash" }`, primitives.RunOptionUnknownError},
+		{"invalid env", `$result run sh [] { env "X=1" }`, primitives.RunOptionInvalidError},
+		{"invalid timeout", `$result run sh [] { timeout 0 }`, primitives.RunOptionInvalidError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := runBlockIn(t, dir, nil, tt.source)
+			assert.ErrorIs(t, err, tt.want)
+		})
+	}
+
+	_, err := runBlockIn(t, dir, nil, `$result run gnd-missing-program`)
+	assert.Error(t, err)
+}
+
+func TestRunDisabledByDefault(t *testing.T) {
+	dir := t.TempDir()
+	allowRun(t)
+	_, err := runBlockIn(t, dir, nil, `$result run sh ["-c" "true"]`)
+	assert.ErrorIs(t, err, primitives.RunNotAllowedError)
+}
@@ -0,0 +1,38 @@
+package primitives
+
+import (
+	"errors"
+
+	"github.com/hyperifyio/gnd/pkg/primitive_services"
+	"github.com/hyperifyio/gnd/pkg/primitive_types"
+)
+
+var SelectInvalidArgumentsError = errors.New("select expects a condition, a value and an optional alternative")
+
+// Select represents the select primitive
+type Select struct{}
+
+var _ primitive_types.Primitive = &Select{}
+
+func (s *Select) Name() string {
+	return "/gnd/select"
+}
+
+// Execute returns the second argument if the condition is truthy, and the
+// third argument, or nil, otherwise
+func (s *Select) Execute(args []interface{}) (interface{}, error) {
+	if len(args) < 2 || len(args) > 3 {
+		return nil, SelectInvalidArgumentsError
+	}
+	if Truthy(args[0]) {
+		return args[1], nil
+	}
+	if len(args) == 3 {
+		return args[2], nil
+	}
+	return nil, nil
+}
+
+func init() {
+	primitive_services.RegisterPrimitive(&Select{})
+}
@@ -0,0 +1,59 @@
+package primitives
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSelect_Execute(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []interface{}
+		want    interface{}
+		wantErr error
+	}{
+		{
+			name: "truthy condition",
+			args: []interface{}{true, "yes", "no"},
+			want: "yes",
+		},
+		{
+			name: "falsy condition",
+			args: []interface{}{0, "yes", "no"},
+			want: "no",
+		},
+		{
+			name: "non-empty string is truthy",
+			args: []interface{}{"false", "yes", "no"},
+			want: "yes",
+		},
+		{
+			name: "falsy condition without alternative",
+			args: []interface{}{"", "yes"},
+			want: nil,
+		},
+		{
+			name:    "missing value",
+			args:    []interface{}{true},
+			wantErr: SelectInvalidArgumentsError,
+		},
+		{
+			name:    "too many arguments",
+			args:    []interface{}{true, 1, 2, 3},
+			wantErr: SelectInvalidArgumentsError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := (&Select{}).Execute(tt.args)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package primitives
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/hyperifyio/gnd/pkg/primitive_services"
+	"github.com/hyperifyio/gnd/pkg/primitive_types"
+)
+
+var SetRequiresArgumentsError = errors.New("set: requires a map, a path and a value")
+var SetEmptyPathError = errors.New("set: path must not be empty")
+
+// Set represents the set primitive
+type Set struct{}
+
+var _ primitive_types.Primitive = &Set{}
+
+func (p *Set) Name() string {
+	return "/gnd/set"
+}
+
+// Execute returns a copy of the first argument with the value at the path
+// replaced. The argument itself is not modified.
+func (p *Set) Execute(args []interface{}) (interface{}, error) {
+	if len(args) != 3 {
+		return nil, SetRequiresArgumentsError
+	}
+	if _, ok := args[0].(map[string]interface{}); !ok {
+		return nil, fmt.Errorf("set: %w", MapInvalidError)
+	}
+	path, err := ParsePath(args[1])
+	if err != nil {
+		return nil, fmt.Errorf("set: %w", err)
+	}
+	if len(path) == 0 {
+		return nil, SetEmptyPathError
+	}
+	result, err := SetPath(args[0], path, args[2])
+	if err != nil {
+		return nil, fmt.Errorf("set: %w", err)
+	}
+	return result, nil
+}
+
+func init() {
+	primitive_services.RegisterPrimitive(&Set{})
+}
@@ -0,0 +1,27 @@
+package primitives
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSet(t *testing.T) {
+	m := map[string]interface{}{"a": int64(1)}
+
+	got, err := (&Set{}).Execute([]interface{}{m, "b", int64(2)})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"a": int64(1), "b": int64(2)}, got)
+	assert.Equal(t, map[string]interface{}{"a": int64(1)}, m)
+
+	got, err = (&Set{}).Execute([]interface{}{m, []interface{}{"x", "y"}, "z"})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"a": int64(1), "x": map[string]interface{}{"y": "z"}}, got)
+
+	_, err = (&Set{}).Execute([]interface{}{m, []interface{}{}, "z"})
+	assert.ErrorIs(t, err, SetEmptyPathError)
+	_, err = (&Set{}).Execute([]interface{}{"m", "a", "z"})
+	assert.ErrorIs(t, err, MapInvalidError)
+	_, err = (&Set{}).Execute([]interface{}{m, "a"})
+	assert.ErrorIs(t, err, SetRequiresArgumentsError)
+}
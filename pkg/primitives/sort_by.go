@@ -0,0 +1,72 @@
+package primitives
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/hyperifyio/gnd/pkg/parsers"
+	"github.com/hyperifyio/gnd/pkg/primitive_services"
+	"github.com/hyperifyio/gnd/pkg/primitive_types"
+)
+
+var SortByIncomparableKeysError = errors.New("sort-by: keys must be all numbers or all strings")
+
+// SortBy represents the sort-by primitive
+type SortBy struct{}
+
+var _ primitive_types.Primitive = &SortBy{}
+var _ primitive_types.BlockSuccessResultHandler = &SortBy{}
+
+func (p *SortBy) Name() string {
+	return "/gnd/sort-by"
+}
+
+// Execute validates the arguments and returns a CollectionResult for the
+// interpreter to handle
+func (p *SortBy) Execute(args []interface{}) (interface{}, error) {
+	return ParseCollectionArgs("sort-by", args, 0)
+}
+
+// HandleBlockSuccessResult runs the routine for each element, with "_" set
+// to the element, and returns a new array of the elements in ascending order
+// of the results. The sort is stable.
+func (p *SortBy) HandleBlockSuccessResult(result interface{}, i primitive_types.Interpreter, destination *parsers.PropertyRef, _ []*parsers.Instruction) (interface{}, error) {
+	c, ok := GetCollectionResult(result)
+	if !ok {
+		return result, nil
+	}
+
+	keys := make([]interface{}, len(c.List))
+	order := make([]int, len(c.List))
+	for idx, item := range c.List {
+		key, err := RunRoutine(i, p.Name(), c.Routine, item)
+		if err != nil {
+			return nil, err
+		}
+		keys[idx] = key
+		order[idx] = idx
+	}
+
+	var compareErr error
+	sort.SliceStable(order, func(a, b int) bool {
+		cmp, ok := CompareValues(keys[order[a]], keys[order[b]])
+		if !ok && compareErr == nil {
+			compareErr = fmt.Errorf("%w: %v and %v", SortByIncomparableKeysError, keys[order[a]], keys[order[b]])
+		}
+		return cmp < 0
+	})
+	if compareErr != nil {
+		return nil, compareErr
+	}
+
+	sorted := make([]interface{}, len(order))
+	for idx, from := range order {
+		sorted[idx] = c.List[from]
+	}
+	return HandleRoutineResult(i, destination, sorted)
+}
+
+func init() {
+	primitive_services.RegisterPrimitive(&SortBy{})
+}
@@ -0,0 +1,36 @@
+package primitives_test
+
+import (
+	"testing"
+
+	"github.com/hyperifyio/gnd/pkg/primitives"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSortBy(t *testing.T) {
+	slots := map[string]interface{}{
+		"key": parseRoutine(t, "first _\n"),
+		"list": []interface{}{
+			[]interface{}{int64(3), "c"},
+			[]interface{}{1.5, "a"},
+			[]interface{}{int64(2), "b"},
+			[]interface{}{int64(2), "b2"},
+		},
+	}
+
+	got, err := runBlock(t, slots, `$result sort-by $key $list`)
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{
+		[]interface{}{1.5, "a"},
+		[]interface{}{int64(2), "b"},
+		[]interface{}{int64(2), "b2"},
+		[]interface{}{int64(3), "c"},
+	}, got)
+
+	got, err = runBlock(t, slots, `$result sort-by $key ["b" "c" "a"]`)
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{"a", "b", "c"}, got)
+
+	_, err = runBlock(t, slots, `$result sort-by $key ["b" 1]`)
+	assert.ErrorIs(t, err, primitives.SortByIncomparableKeysError)
+}
@@ -0,0 +1,49 @@
+package primitives
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/hyperifyio/gnd/pkg/primitive_services"
+	"github.com/hyperifyio/gnd/pkg/primitive_types"
+)
+
+var SplitRequiresArgumentsError = errors.New("split: requires a string and an optional separator")
+
+// Split represents the split primitive
+type Split struct{}
+
+var _ primitive_types.Primitive = &Split{}
+
+func (p *Split) Name() string {
+	return "/gnd/split"
+}
+
+// Execute splits a string at every separator. Without a separator the
+// string is split into words at runs of whitespace, and an empty separator
+// splits it into characters.
+func (p *Split) Execute(args []interface{}) (interface{}, error) {
+	if len(args) < 1 || len(args) > 2 {
+		return nil, SplitRequiresArgumentsError
+	}
+	strs, err := stringArgs("split", args)
+	if err != nil {
+		return nil, err
+	}
+
+	var parts []string
+	if len(strs) == 1 {
+		parts = strings.Fields(strs[0])
+	} else {
+		parts = strings.Split(strs[0], strs[1])
+	}
+	result := make([]interface{}, len(parts))
+	for i, part := range parts {
+		result[i] = part
+	}
+	return result, nil
+}
+
+func init() {
+	primitive_services.RegisterPrimitive(&Split{})
+}
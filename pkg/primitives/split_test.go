@@ -0,0 +1,33 @@
+package primitives
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplit(t *testing.T) {
+	tests := []struct {
+		name string
+		args []interface{}
+		want interface{}
+	}{
+		{"separator", []interface{}{"a,b,,c", ","}, []interface{}{"a", "b", "", "c"}},
+		{"words", []interface{}{"  one two\n\tthree "}, []interface{}{"one", "two", "three"}},
+		{"characters", []interface{}{"äö€", ""}, []interface{}{"ä", "ö", "€"}},
+		{"multi-character separator", []interface{}{"a--b", "--"}, []interface{}{"a", "b"}},
+		{"empty string", []interface{}{""}, []interface{}{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := (&Split{}).Execute(tt.args)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	_, err := (&Split{}).Execute([]interface{}{int64(1), ","})
+	assert.ErrorIs(t, err, StringArgumentInvalidError)
+	_, err = (&Split{}).Execute([]interface{}{})
+	assert.ErrorIs(t, err, SplitRequiresArgumentsError)
+}
@@ -0,0 +1,36 @@
+package primitives
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/hyperifyio/gnd/pkg/primitive_services"
+	"github.com/hyperifyio/gnd/pkg/primitive_types"
+)
+
+var StartsWithRequiresArgumentsError = errors.New("starts-with: requires a string and a prefix")
+
+// StartsWith represents the starts-with primitive
+type StartsWith struct{}
+
+var _ primitive_types.Primitive = &StartsWith{}
+
+func (p *StartsWith) Name() string {
+	return "/gnd/starts-with"
+}
+
+// Execute returns true if the string starts with the prefix
+func (p *StartsWith) Execute(args []interface{}) (interface{}, error) {
+	if len(args) != 2 {
+		return nil, StartsWithRequiresArgumentsError
+	}
+	strs, err := stringArgs("starts-with", args)
+	if err != nil {
+		return nil, err
+	}
+	return strings.HasPrefix(strs[0], strs[1]), nil
+}
+
+func init() {
+	primitive_services.RegisterPrimitive(&StartsWith{})
+}
@@ -0,0 +1,20 @@
+package primitives
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStartsWith(t *testing.T) {
+	got, err := (&StartsWith{}).Execute([]interface{}{"```json", "```"})
+	assert.NoError(t, err)
+	assert.Equal(t, true, got)
+
+	got, err = (&StartsWith{}).Execute([]interface{}{"hello", "ello"})
+	assert.NoError(t, err)
+	assert.Equal(t, false, got)
+
+	_, err = (&StartsWith{}).Execute([]interface{}{"hello"})
+	assert.ErrorIs(t, err, StartsWithRequiresArgumentsError)
+}
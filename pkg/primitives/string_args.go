@@ -0,0 +1,49 @@
+package primitives
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+var (
+	StringArgumentInvalidError  = errors.New("argument must be a string")
+	IntegerArgumentInvalidError = errors.New("argument must be an integer")
+	StringSearchEmptyError      = errors.New("search string must not be empty")
+)
+
+// stringArg returns args[index] of the primitive name as a string
+func stringArg(name string, args []interface{}, index int) (string, error) {
+	s, ok := args[index].(string)
+	if !ok {
+		return "", fmt.Errorf("%s: %w: %v (%T)", name, StringArgumentInvalidError, args[index], args[index])
+	}
+	return s, nil
+}
+
+// stringArgs returns the arguments of the primitive name as strings
+func stringArgs(name string, args []interface{}) ([]string, error) {
+	result := make([]string, len(args))
+	for index := range args {
+		s, err := stringArg(name, args, index)
+		if err != nil {
+			return nil, err
+		}
+		result[index] = s
+	}
+	return result, nil
+}
+
+// intArg returns args[index] of the primitive name as an int. Numeric
+// strings and floats without a fraction are accepted.
+func intArg(name string, args []interface{}, index int) (int, error) {
+	n, err := ToNumber(args[index])
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w: %v (%T)", name, IntegerArgumentInvalidError, args[index], args[index])
+	}
+	f := toFloat64(n)
+	if f != math.Trunc(f) || f < math.MinInt32 || f > math.MaxInt32 {
+		return 0, fmt.Errorf("%s: %w: %v", name, IntegerArgumentInvalidError, args[index])
+	}
+	return int(f), nil
+}
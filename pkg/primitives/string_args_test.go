@@ -0,0 +1,36 @@
+package primitives
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIntArg(t *testing.T) {
+	tests := []struct {
+		name    string
+		arg     interface{}
+		want    int
+		wantErr bool
+	}{
+		{"int64", int64(3), 3, false},
+		{"uint8", uint8(3), 3, false},
+		{"whole float", 3.0, 3, false},
+		{"numeric string", " -2 ", -2, false},
+		{"fraction", 3.5, 0, true},
+		{"too large", int64(1) << 40, 0, true},
+		{"word", "three", 0, true},
+		{"array", []interface{}{int64(1)}, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := intArg("test", []interface{}{tt.arg}, 0)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, IntegerArgumentInvalidError)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
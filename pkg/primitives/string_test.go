@@ -27,6 +27,18 @@ func TestString_Execute(t *testing.T) {
 			want:    "\"hello world\"",
 			wantErr: false,
 		},
+		{
+			name:    "numeric string value",
+			arg:     []interface{}{"42"},
+			want:    "42",
+			wantErr: false,
+		},
+		{
+			name:    "string value with brackets",
+			arg:     []interface{}{"[ok]"},
+			want:    "[ok]",
+			wantErr: false,
+		},
 		{
 			name:    "int value",
 			arg:     []interface{}{42},
@@ -0,0 +1,39 @@
+package primitives
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/hyperifyio/gnd/pkg/primitive_services"
+	"github.com/hyperifyio/gnd/pkg/primitive_types"
+)
+
+var SubRequiresArgumentsError = errors.New("sub: requires at least two arguments")
+
+// Sub represents the sub primitive
+type Sub struct{}
+
+var _ primitive_types.Primitive = &Sub{}
+
+func (p *Sub) Name() string {
+	return "/gnd/sub"
+}
+
+// Execute subtracts the remaining arguments from the first one
+func (p *Sub) Execute(args []interface{}) (interface{}, error) {
+	if len(args) < 2 {
+		return nil, SubRequiresArgumentsError
+	}
+	return foldArithmetic("sub", args, arithmeticOp{
+		ints: func(a, b *big.Int) (*big.Int, error) {
+			return new(big.Int).Sub(a, b), nil
+		},
+		floats: func(a, b float64) (float64, error) {
+			return a - b, nil
+		},
+	})
+}
+
+func init() {
+	primitive_services.RegisterPrimitive(&Sub{})
+}
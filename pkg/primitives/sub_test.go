@@ -0,0 +1,37 @@
+package primitives
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSub(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []interface{}
+		want    interface{}
+		wantErr error
+	}{
+		{"fold", []interface{}{int64(10), int64(1), int64(2)}, int64(7), nil},
+		{"negative result", []interface{}{int64(1), int64(3)}, int64(-2), nil},
+		{"unsigned underflow", []interface{}{uint(1), uint(3)}, nil, ArithmeticOverflowError},
+		{"mixed signed and unsigned", []interface{}{uint(1), int64(3)}, int64(-2), nil},
+		{"float", []interface{}{2.5, "1"}, 1.5, nil},
+		{"int64 underflow", []interface{}{int64(math.MinInt64), int64(1)}, nil, ArithmeticOverflowError},
+		{"no arguments", []interface{}{}, nil, SubRequiresArgumentsError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := (&Sub{}).Execute(tt.args)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
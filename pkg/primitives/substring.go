@@ -0,0 +1,63 @@
+package primitives
+
+import (
+	"errors"
+
+	"github.com/hyperifyio/gnd/pkg/primitive_services"
+	"github.com/hyperifyio/gnd/pkg/primitive_types"
+)
+
+var SubstringRequiresArgumentsError = errors.New("substring: requires a string, a start index and an optional end index")
+
+// Substring represents the substring primitive
+type Substring struct{}
+
+var _ primitive_types.Primitive = &Substring{}
+
+func (p *Substring) Name() string {
+	return "/gnd/substring"
+}
+
+// Execute returns the characters from the start index up to but not
+// including the end index, which defaults to the end of the string. Indexes
+// count characters, negative indexes count from the end of the string, and
+// indexes beyond either end are clamped.
+func (p *Substring) Execute(args []interface{}) (interface{}, error) {
+	if len(args) < 2 || len(args) > 3 {
+		return nil, SubstringRequiresArgumentsError
+	}
+	s, err := stringArg("substring", args, 0)
+	if err != nil {
+		return nil, err
+	}
+	runes := []rune(s)
+	start, err := intArg("substring", args, 1)
+	if err != nil {
+		return nil, err
+	}
+	end := len(runes)
+	if len(args) == 3 {
+		if end, err = intArg("substring", args, 2); err != nil {
+			return nil, err
+		}
+	}
+
+	start, end = clampIndex(start, len(runes)), clampIndex(end, len(runes))
+	if start >= end {
+		return "", nil
+	}
+	return string(runes[start:end]), nil
+}
+
+// clampIndex converts a possibly negative index into an index between 0 and
+// length
+func clampIndex(index, length int) int {
+	if index < 0 {
+		index += length
+	}
+	return max(0, min(index, length))
+}
+
+func init() {
+	primitive_services.RegisterPrimitive(&Substring{})
+}
@@ -0,0 +1,40 @@
+package primitives
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSubstring(t *testing.T) {
+	tests := []struct {
+		name string
+		args []interface{}
+		want string
+	}{
+		{"start and end", []interface{}{"hello", int64(1), int64(3)}, "el"},
+		{"start only", []interface{}{"hello", int64(2)}, "llo"},
+		{"characters not bytes", []interface{}{"häällo", int64(1), int64(3)}, "ää"},
+		{"negative start", []interface{}{"hello", int64(-3)}, "llo"},
+		{"negative end", []interface{}{"hello", int64(0), int64(-1)}, "hell"},
+		{"clamped", []interface{}{"hello", int64(-10), int64(10)}, "hello"},
+		{"empty range", []interface{}{"hello", int64(3), int64(1)}, ""},
+		{"numeric strings", []interface{}{"hello", "1", "2"}, "e"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := (&Substring{}).Execute(tt.args)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	_, err := (&Substring{}).Execute([]interface{}{"hello", 1.5})
+	assert.ErrorIs(t, err, IntegerArgumentInvalidError)
+	_, err = (&Substring{}).Execute([]interface{}{"hello", "x"})
+	assert.ErrorIs(t, err, IntegerArgumentInvalidError)
+	_, err = (&Substring{}).Execute([]interface{}{int64(1), int64(0)})
+	assert.ErrorIs(t, err, StringArgumentInvalidError)
+	_, err = (&Substring{}).Execute([]interface{}{"hello"})
+	assert.ErrorIs(t, err, SubstringRequiresArgumentsError)
+}
@@ -2,15 +2,20 @@ package primitives
 
 import (
 	"errors"
+	"fmt"
+
 	"github.com/hyperifyio/gnd/pkg/loggers"
 	"github.com/hyperifyio/gnd/pkg/parsers"
 	"github.com/hyperifyio/gnd/pkg/primitive_services"
 	"github.com/hyperifyio/gnd/pkg/primitive_types"
+	"github.com/hyperifyio/gnd/pkg/runtime_errors"
 )
 
 // Predefined errors
 var ThrowErrNoArguments = errors.New("throw: requires at least one argument")
 var ThrowInvalidArgument = errors.New("throw: invalid argument")
+var ThrowInvalidCode = errors.New("throw: code must be lowercase words separated by hyphens")
+var ThrowInvalidErrorMap = errors.New("throw: error map may only have a code, message, data and cause")
 
 // Throw represents the throw primitive
 type Throw struct{}
@@ -22,13 +27,34 @@ func (t *Throw) Name() string {
 	return "/gnd/throw"
 }
 
-// Execute runs the throw primitive
+// Execute runs the throw primitive. The arguments are joined into the
+// message of an ErrorValue with DefaultErrorCode. If the last of several
+// arguments is an ErrorValue, it is the cause and not part of the message. A
+// single ErrorValue, e.g. one caught by try, is thrown again as it is. A
+// single map with a code key is an error with that code and the optional
+// message, data and cause of the map.
 func (t *Throw) Execute(args []interface{}) (interface{}, error) {
 
 	// If no arguments provided, return an error
 	if len(args) == 0 {
 		return nil, ThrowErrNoArguments
 	}
+	if len(args) == 1 {
+		if value, ok := args[0].(*runtime_errors.ErrorValue); ok {
+			return nil, value
+		}
+		if fields, ok := args[0].(map[string]interface{}); ok {
+			if _, hasCode := fields["code"]; hasCode {
+				return nil, newThrownError(fields)
+			}
+		}
+	}
+
+	var cause error
+	if value, ok := args[len(args)-1].(*runtime_errors.ErrorValue); ok && len(args) > 1 {
+		cause = value
+		args = args[:len(args)-1]
+	}
 
 	// Convert arguments to string using ParseString
 	str := ""
@@ -45,7 +71,38 @@ func (t *Throw) Execute(args []interface{}) (interface{}, error) {
 	}
 
 	// Return an error with the composed message
-	return nil, errors.New(str)
+	return nil, runtime_errors.NewErrorValue(runtime_errors.DefaultErrorCode, str, nil, cause)
+}
+
+// newThrownError creates the ErrorValue described by an error map. The
+// message defaults to the code.
+func newThrownError(fields map[string]interface{}) error {
+	code, ok := fields["code"].(string)
+	if !ok || !runtime_errors.IsErrorCode(code) {
+		return fmt.Errorf("%w: %v", ThrowInvalidCode, fields["code"])
+	}
+	message := code
+	var cause error
+	for _, key := range SortedKeys(fields) {
+		switch key {
+		case "code", "data":
+		case "message":
+			s, ok := fields[key].(string)
+			if !ok {
+				return fmt.Errorf("%w: message must be a string", ThrowInvalidErrorMap)
+			}
+			message = s
+		case "cause":
+			value, ok := fields[key].(*runtime_errors.ErrorValue)
+			if !ok {
+				return fmt.Errorf("%w: cause must be an error value", ThrowInvalidErrorMap)
+			}
+			cause = value
+		default:
+			return fmt.Errorf("%w: %s", ThrowInvalidErrorMap, key)
+		}
+	}
+	return runtime_errors.NewErrorValue(code, message, fields["data"], cause)
 }
 
 func init() {
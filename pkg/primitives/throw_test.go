@@ -3,6 +3,7 @@ package primitives
 import (
 	"testing"
 
+	"github.com/hyperifyio/gnd/pkg/runtime_errors"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -49,3 +50,62 @@ func TestThrowPrimitive(t *testing.T) {
 		})
 	}
 }
+
+func TestThrowErrorValue(t *testing.T) {
+	details := map[string]interface{}{"field": "name"}
+	cause := runtime_errors.NewErrorValue("timeout", "slow", nil, nil)
+
+	tests := []struct {
+		name    string
+		args    []interface{}
+		code    string
+		message string
+		data    interface{}
+		cause   error
+	}{
+		{"message only", []interface{}{"bad", "input"}, runtime_errors.DefaultErrorCode, "bad input", nil, nil},
+		{"first argument is not a code", []interface{}{"timeout", "x"}, runtime_errors.DefaultErrorCode, "timeout x", nil, nil},
+		{"message and cause", []interface{}{"fetch", "failed", cause}, runtime_errors.DefaultErrorCode, "fetch failed", nil, cause},
+		{"map without a code", []interface{}{details}, runtime_errors.DefaultErrorCode, "{ field name }", nil, nil},
+		{"code only", []interface{}{map[string]interface{}{"code": "timeout"}}, "timeout", "timeout", nil, nil},
+		{"code and message", []interface{}{map[string]interface{}{"code": "invalid-input", "message": "name is missing"}}, "invalid-input", "name is missing", nil, nil},
+		{"code and data", []interface{}{map[string]interface{}{"code": "invalid-input", "data": details}}, "invalid-input", "invalid-input", details, nil},
+		{"code and cause", []interface{}{map[string]interface{}{"code": "fetch-failed", "cause": cause}}, "fetch-failed", "fetch-failed", nil, cause},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := (&Throw{}).Execute(tt.args)
+			value, ok := err.(*runtime_errors.ErrorValue)
+			assert.True(t, ok)
+			assert.Equal(t, tt.code, value.Code)
+			assert.Equal(t, tt.message, value.Message)
+			assert.Equal(t, tt.data, value.Data)
+			assert.Equal(t, tt.cause, value.Cause)
+		})
+	}
+
+	t.Run("error value is thrown again", func(t *testing.T) {
+		_, err := (&Throw{}).Execute([]interface{}{cause})
+		assert.Same(t, cause, err)
+	})
+}
+
+func TestThrowErrorMap(t *testing.T) {
+	tests := []struct {
+		name   string
+		fields map[string]interface{}
+		err    error
+	}{
+		{"code is not a string", map[string]interface{}{"code": int64(1)}, ThrowInvalidCode},
+		{"code has an invalid form", map[string]interface{}{"code": "Bad Code"}, ThrowInvalidCode},
+		{"message is not a string", map[string]interface{}{"code": "bad", "message": int64(1)}, ThrowInvalidErrorMap},
+		{"cause is not an error", map[string]interface{}{"code": "bad", "cause": "x"}, ThrowInvalidErrorMap},
+		{"unknown key", map[string]interface{}{"code": "bad", "detail": "x"}, ThrowInvalidErrorMap},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := (&Throw{}).Execute([]interface{}{tt.fields})
+			assert.ErrorIs(t, err, tt.err)
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package primitives
+
+import (
+	"reflect"
+)
+
+// Truthy returns the boolean value of v as the bool primitive defines it:
+// nil, false, zero numbers and empty strings, arrays and maps are false, and
+// any other value of those types is true. Values of other types are false.
+func Truthy(v interface{}) bool {
+
+	// Handle nil/none
+	if v == nil {
+		return false
+	}
+
+	// Handle boolean
+	if boolVal, ok := v.(bool); ok {
+		return boolVal
+	}
+
+	// Handle numbers
+	switch n := v.(type) {
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+		return reflect.ValueOf(n).Convert(reflect.TypeOf(float64(0))).Float() != 0
+	}
+
+	// Handle string
+	if str, ok := v.(string); ok {
+		return len(str) > 0
+	}
+
+	// Handle arrays and maps
+	switch c := v.(type) {
+	case []interface{}:
+		return len(c) > 0
+	case map[string]interface{}:
+		return len(c) > 0
+	}
+
+	// For any other type, return false
+	return false
+}
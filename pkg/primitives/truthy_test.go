@@ -0,0 +1,33 @@
+package primitives
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTruthy(t *testing.T) {
+	tests := []struct {
+		value interface{}
+		want  bool
+	}{
+		{nil, false},
+		{true, true},
+		{false, false},
+		{0, false},
+		{int64(-1), true},
+		{uint8(0), false},
+		{0.0, false},
+		{float32(0.5), true},
+		{"", false},
+		{"false", true},
+		{[]interface{}{}, false},
+		{[]interface{}{nil}, true},
+		{map[string]interface{}{}, false},
+		{map[string]interface{}{"a": 1}, true},
+		{struct{}{}, false},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, Truthy(tt.value), "%#v", tt.value)
+	}
+}
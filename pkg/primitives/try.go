@@ -0,0 +1,99 @@
+package primitives
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/hyperifyio/gnd/pkg/parsers"
+	"github.com/hyperifyio/gnd/pkg/primitive_services"
+	"github.com/hyperifyio/gnd/pkg/primitive_types"
+	"github.com/hyperifyio/gnd/pkg/runtime_errors"
+)
+
+var (
+	TryRequiresArgumentsError = errors.New("try: requires a routine, an optional argument array and an optional handler routine")
+	TryRoutineInvalidError    = errors.New("try: routine must be an instruction array or instruction")
+	TryArgumentsInvalidError  = errors.New("try: arguments must be an array")
+	TryHandlerInvalidError    = errors.New("try: handler must be an instruction array or instruction")
+)
+
+// TryRequest is returned by the Execute method of the try primitive
+type TryRequest struct {
+	Routine []*parsers.Instruction
+	// Args are the input of the routine
+	Args []interface{}
+	// Handler is nil if no handler routine was given
+	Handler []*parsers.Instruction
+}
+
+// String returns a string representation of the TryRequest
+func (r *TryRequest) String() string {
+	return fmt.Sprintf("TryRequest{routine: %v, args: %v, handler: %v}", r.Routine, r.Args, r.Handler)
+}
+
+// Try represents the try primitive
+type Try struct{}
+
+var _ primitive_types.Primitive = &Try{}
+var _ primitive_types.BlockSuccessResultHandler = &Try{}
+
+func (p *Try) Name() string {
+	return "/gnd/try"
+}
+
+// Execute validates the arguments and returns a TryRequest for the
+// interpreter to handle
+func (p *Try) Execute(args []interface{}) (interface{}, error) {
+	if len(args) < 1 || len(args) > 3 {
+		return nil, TryRequiresArgumentsError
+	}
+	routine, ok := ToRoutine(args[0])
+	if !ok {
+		return nil, TryRoutineInvalidError
+	}
+	request := &TryRequest{Routine: routine, Args: []interface{}{}}
+	if len(args) >= 2 {
+		if request.Args, ok = args[1].([]interface{}); !ok {
+			return nil, TryArgumentsInvalidError
+		}
+	}
+	if len(args) == 3 {
+		if request.Handler, ok = ToRoutine(args[2]); !ok {
+			return nil, TryHandlerInvalidError
+		}
+	}
+	return request, nil
+}
+
+// HandleBlockSuccessResult runs the routine with the arguments as its input
+// and returns [true value] if it succeeds. If it fails, the result is
+// [false error], where error is the ErrorValue of the original error, or the
+// result of the handler routine run with the error as its input. Exits are
+// not caught.
+func (p *Try) HandleBlockSuccessResult(result interface{}, i primitive_types.Interpreter, destination *parsers.PropertyRef, _ []*parsers.Instruction) (interface{}, error) {
+	request, ok := result.(*TryRequest)
+	if !ok {
+		return result, nil
+	}
+
+	value, err := RunRoutine(i, "/gnd/try", request.Routine, request.Args)
+	if err == nil {
+		return HandleRoutineResult(i, destination, []interface{}{true, value})
+	}
+	if _, ok := GetExitResult(err); ok {
+		return nil, err
+	}
+	i.LogDebug("[/gnd/try]: caught error: %v", err)
+
+	var caught interface{} = runtime_errors.ToErrorValue(err)
+	if request.Handler != nil {
+		if caught, err = RunRoutine(i, "/gnd/try", request.Handler, caught); err != nil {
+			return nil, err
+		}
+	}
+	return HandleRoutineResult(i, destination, []interface{}{false, caught})
+}
+
+func init() {
+	primitive_services.RegisterPrimitive(&Try{})
+}
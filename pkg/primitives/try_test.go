@@ -0,0 +1,118 @@
+package primitives_test
+
+import (
+	"testing"
+
+	"github.com/hyperifyio/gnd/pkg/primitives"
+	"github.com/hyperifyio/gnd/pkg/runtime_errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTry(t *testing.T) {
+	slots := map[string]interface{}{
+		"ok":      concatRoutine(),
+		"fail":    parseRoutine(t, "throw \"bad input:\" _\n"),
+		"divide":  parseRoutine(t, "div 1 0\n"),
+		"nested":  parseRoutine(t, "$f compile \"throw deep\"\nexec $f\n"),
+		"early":   parseRoutine(t, "return \"early\"\nthrow never\n"),
+		"handler": parseRoutine(t, "concat \"handled: \" _\n"),
+		"broken":  parseRoutine(t, "throw \"handler failed\"\n"),
+		"exit":    parseRoutine(t, "exit 3\n"),
+	}
+
+	tests := []struct {
+		name   string
+		source string
+		want   interface{}
+	}{
+		{
+			name:   "success",
+			source: `$result try $ok ["a" "b"]`,
+			want:   []interface{}{true, "ab"},
+		},
+		{
+			name:   "return inside the routine",
+			source: `$result try $early`,
+			want:   []interface{}{true, "early"},
+		},
+		{
+			name:   "handler",
+			source: `$result try $divide [] $handler`,
+			want:   []interface{}{false, "handled: div: division by zero"},
+		},
+		{
+			name:   "message of a thrown error",
+			source: "$r try $fail [x]\n$e get $r 1\n$result error-message $e",
+			want:   "\"bad input:\" [ x ]",
+		},
+		{
+			name:   "message of a primitive error",
+			source: "$r try $divide\n$e get $r 1\n$result error-message $e",
+			want:   "div: division by zero",
+		},
+		{
+			name:   "message of an error of a nested routine",
+			source: "$r try $nested\n$e get $r 1\n$result error-message $e",
+			want:   "deep",
+		},
+		{
+			name:   "handler is not run on success",
+			source: `$result try $ok [a] $broken`,
+			want:   []interface{}{true, "a"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := runBlock(t, slots, tt.source)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	t.Run("error value", func(t *testing.T) {
+		got, err := runBlock(t, slots, `$result try $divide`)
+		assert.NoError(t, err)
+		value := got.([]interface{})[1].(*runtime_errors.ErrorValue)
+		assert.Equal(t, runtime_errors.DefaultErrorCode, value.Code)
+		assert.Equal(t, "routine:1:1", value.Position)
+		assert.ErrorIs(t, value, primitives.ArithmeticDivisionByZeroError)
+	})
+
+	t.Run("execution continues after a failure", func(t *testing.T) {
+		got, err := runBlock(t, slots, "$a try $divide\n$result concat \"after\"\n")
+		assert.NoError(t, err)
+		assert.Equal(t, "after", got)
+	})
+
+	t.Run("failing handler", func(t *testing.T) {
+		_, err := runBlock(t, slots, `$result try $divide [] $broken`)
+		assert.ErrorContains(t, err, "handler failed")
+	})
+
+	t.Run("exit is not caught", func(t *testing.T) {
+		_, err := runBlock(t, slots, `$result try $exit`)
+		exit, ok := primitives.GetExitResult(err)
+		assert.True(t, ok)
+		assert.Equal(t, 3, exit.Code)
+	})
+}
+
+func TestTryErrors(t *testing.T) {
+	slots := map[string]interface{}{"ok": concatRoutine()}
+	tests := []struct {
+		name   string
+		source string
+		want   error
+	}{
+		{"routine not a routine", `$result try 1`, primitives.TryRoutineInvalidError},
+		{"arguments not an array", `$result try $ok "a"`, primitives.TryArgumentsInvalidError},
+		{"handler not a routine", `$result try $ok [] 1`, primitives.TryHandlerInvalidError},
+		{"too many arguments", `$result try $ok [] $ok $ok`, primitives.TryRequiresArgumentsError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := runBlock(t, slots, tt.source)
+			assert.ErrorIs(t, err, tt.want)
+		})
+	}
+}
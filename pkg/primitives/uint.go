@@ -41,6 +41,11 @@ func (u *UintType) Execute(args []interface{}) (interface{}, error) {
 			return nil, UintInvalidArgumentError
 		}
 		return uint(v), nil
+	case int64:
+		if v < 0 {
+			return nil, UintInvalidArgumentError
+		}
+		return uint(v), nil
 	case float32:
 		if v < 0 {
 			return nil, UintInvalidArgumentError
@@ -42,6 +42,14 @@ func (u *Uint16Type) Execute(args []interface{}) (interface{}, error) {
 		return uint16(value), nil
 	}
 
+	// Handle int64
+	if value, ok := arg.(int64); ok {
+		if value < 0 || value > math.MaxUint16 {
+			return nil, fmt.Errorf("uint16 overflow: value %d outside range 0..65535", value)
+		}
+		return uint16(value), nil
+	}
+
 	// Handle float64
 	if value, ok := arg.(float64); ok {
 		if value != float64(int64(value)) {
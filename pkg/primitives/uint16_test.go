@@ -28,6 +28,12 @@ func TestUint16_Execute(t *testing.T) {
 			want:    443,
 			wantErr: false,
 		},
+		{
+			name:    "decimal int64",
+			arg:     int64(443),
+			want:    443,
+			wantErr: false,
+		},
 		{
 			name:    "zero",
 			arg:     0,
@@ -48,6 +48,14 @@ func (u *Uint32Type) Execute(args []interface{}) (interface{}, error) {
 		return uint32(value), nil
 	}
 
+	// Handle int64
+	if value, ok := arg.(int64); ok {
+		if value < 0 || value > math.MaxUint32 {
+			return nil, fmt.Errorf("uint32 overflow: value %d outside range 0..4294967295", value)
+		}
+		return uint32(value), nil
+	}
+
 	// Handle float64
 	if value, ok := arg.(float64); ok {
 		if value != float64(int64(value)) {
@@ -28,6 +28,12 @@ func TestUint32_Execute(t *testing.T) {
 			want:    123456789,
 			wantErr: false,
 		},
+		{
+			name:    "decimal int64",
+			arg:     int64(123456789),
+			want:    123456789,
+			wantErr: false,
+		},
 		{
 			name:    "zero",
 			arg:     0,
@@ -47,6 +47,14 @@ func (u *Uint64Type) Execute(args []interface{}) (interface{}, error) {
 		return uint64(value), nil
 	}
 
+	// Handle int64
+	if value, ok := arg.(int64); ok {
+		if value < 0 {
+			return nil, fmt.Errorf("uint64 overflow: value %d outside range 0..18446744073709551615", value)
+		}
+		return uint64(value), nil
+	}
+
 	// Handle float64
 	if value, ok := arg.(float64); ok {
 		if value != float64(int64(value)) {
@@ -48,6 +48,14 @@ func (u *Uint8Type) Execute(args []interface{}) (interface{}, error) {
 		return uint8(value), nil
 	}
 
+	// Handle int64
+	if value, ok := arg.(int64); ok {
+		if value < 0 || value > math.MaxUint8 {
+			return nil, fmt.Errorf("uint8 overflow: value %d outside range 0..255", value)
+		}
+		return uint8(value), nil
+	}
+
 	// Handle float64
 	if value, ok := arg.(float64); ok {
 		if value != float64(int64(value)) {
@@ -28,6 +28,12 @@ func TestUint8_Execute(t *testing.T) {
 			want:    200,
 			wantErr: false,
 		},
+		{
+			name:    "decimal int64",
+			arg:     int64(200),
+			want:    200,
+			wantErr: false,
+		},
 		{
 			name:    "zero",
 			arg:     0,
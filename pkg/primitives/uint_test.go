@@ -31,6 +31,12 @@ func TestUint_Execute(t *testing.T) {
 			want:    1234567890,
 			wantErr: false,
 		},
+		{
+			name:    "decimal int64",
+			arg:     int64(1234567890),
+			want:    1234567890,
+			wantErr: false,
+		},
 		{
 			name:    "zero",
 			arg:     0,
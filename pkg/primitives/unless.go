@@ -0,0 +1,37 @@
+package primitives
+
+import (
+	"errors"
+
+	"github.com/hyperifyio/gnd/pkg/parsers"
+	"github.com/hyperifyio/gnd/pkg/primitive_services"
+	"github.com/hyperifyio/gnd/pkg/primitive_types"
+)
+
+var UnlessRequiresRoutineError = errors.New("unless: requires a condition and a routine")
+var UnlessRoutineInvalidError = errors.New("unless: routine must be an instruction array or instruction")
+
+// Unless represents the unless primitive
+type Unless struct{}
+
+var _ primitive_types.Primitive = &Unless{}
+var _ primitive_types.BlockSuccessResultHandler = &Unless{}
+
+func (u *Unless) Name() string {
+	return "/gnd/unless"
+}
+
+// Execute returns an ExecResult for the routine if the condition is falsy,
+// and nil otherwise
+func (u *Unless) Execute(args []interface{}) (interface{}, error) {
+	return executeConditional(args, false, UnlessRequiresRoutineError, UnlessRoutineInvalidError)
+}
+
+// HandleBlockSuccessResult runs the routine chosen by Execute
+func (u *Unless) HandleBlockSuccessResult(result interface{}, i primitive_types.Interpreter, destination *parsers.PropertyRef, _ []*parsers.Instruction) (interface{}, error) {
+	return HandleRoutineResult(i, destination, result)
+}
+
+func init() {
+	primitive_services.RegisterPrimitive(&Unless{})
+}
@@ -0,0 +1,26 @@
+package primitives_test
+
+import (
+	"testing"
+
+	"github.com/hyperifyio/gnd/pkg/primitives"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnless(t *testing.T) {
+	slots := map[string]interface{}{"r": concatRoutine()}
+
+	got, err := runBlock(t, slots, `$result unless "" $r "a" "b"`)
+	assert.NoError(t, err)
+	assert.Equal(t, "ab", got)
+
+	got, err = runBlock(t, slots, `$result unless 1 $r "a" "b"`)
+	assert.NoError(t, err)
+	assert.Nil(t, got)
+
+	_, err = runBlock(t, slots, `$result unless false 42`)
+	assert.ErrorIs(t, err, primitives.UnlessRoutineInvalidError)
+
+	_, err = (&primitives.Unless{}).Execute([]interface{}{})
+	assert.ErrorIs(t, err, primitives.UnlessRequiresRoutineError)
+}
@@ -0,0 +1,42 @@
+package primitives
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/hyperifyio/gnd/pkg/primitive_services"
+	"github.com/hyperifyio/gnd/pkg/primitive_types"
+)
+
+var ValuesRequiresArgumentError = errors.New("values: requires exactly one map")
+
+// Values represents the values primitive
+type Values struct{}
+
+var _ primitive_types.Primitive = &Values{}
+
+func (p *Values) Name() string {
+	return "/gnd/values"
+}
+
+// Execute returns the values of a map as an array, in the ascending order of
+// their keys
+func (p *Values) Execute(args []interface{}) (interface{}, error) {
+	if len(args) != 1 {
+		return nil, ValuesRequiresArgumentError
+	}
+	m, ok := args[0].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("values: %w", MapInvalidError)
+	}
+	keys := SortedKeys(m)
+	result := make([]interface{}, len(keys))
+	for i, key := range keys {
+		result[i] = m[key]
+	}
+	return result, nil
+}
+
+func init() {
+	primitive_services.RegisterPrimitive(&Values{})
+}
@@ -0,0 +1,18 @@
+package primitives
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValues(t *testing.T) {
+	got, err := (&Values{}).Execute([]interface{}{map[string]interface{}{"b": int64(1), "a": int64(2)}})
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{int64(2), int64(1)}, got)
+
+	_, err = (&Values{}).Execute([]interface{}{[]interface{}{}})
+	assert.ErrorIs(t, err, MapInvalidError)
+	_, err = (&Values{}).Execute([]interface{}{})
+	assert.ErrorIs(t, err, ValuesRequiresArgumentError)
+}
@@ -1,11 +1,13 @@
 package primitives
 
 import (
+	"context"
 	"errors"
 	"time"
 
 	"github.com/hyperifyio/gnd/pkg/primitive_services"
 	"github.com/hyperifyio/gnd/pkg/primitive_types"
+	"github.com/hyperifyio/gnd/pkg/runtime_errors"
 )
 
 // Predefined errors
@@ -13,12 +15,14 @@ var (
 	WaitErrNoArguments     = errors.New("wait: requires an argument")
 	WaitErrInvalidArgument = errors.New("wait: argument must be a task or number")
 	WaitErrTooManyArgs     = errors.New("wait: too many arguments")
+	WaitErrInvalidTimeout  = errors.New("wait: timeout must be a positive number of milliseconds")
+	WaitErrTimeout         = errors.New("wait: timed out")
 )
 
 // Wait represents the wait primitive
 type Wait struct{}
 
-var _ primitive_types.Primitive = &Wait{}
+var _ primitive_types.ContextPrimitive = &Wait{}
 
 // Name returns the name of the primitive
 func (w *Wait) Name() string {
@@ -27,53 +31,58 @@ func (w *Wait) Name() string {
 
 // Execute runs the wait primitive
 func (w *Wait) Execute(args []interface{}) (interface{}, error) {
+	return w.ExecuteWithContext(context.Background(), args)
+}
+
+// ExecuteWithContext runs the wait primitive. A sleep or a wait for a task
+// ends early with an error when ctx is done.
+func (w *Wait) ExecuteWithContext(ctx context.Context, args []interface{}) (interface{}, error) {
 	if len(args) == 0 {
 		return nil, WaitErrNoArguments
 	}
 
-	if len(args) > 1 {
+	if len(args) > 2 {
 		return nil, WaitErrTooManyArgs
 	}
 
-	// Check if the argument is a number (duration in milliseconds)
-	switch v := args[0].(type) {
-	case float64:
-		time.Sleep(time.Duration(v) * time.Millisecond)
-	case int:
-		time.Sleep(time.Duration(v) * time.Millisecond)
-	case int64:
-		time.Sleep(time.Duration(v) * time.Millisecond)
-	case int32:
-		time.Sleep(time.Duration(v) * time.Millisecond)
-	case int16:
-		time.Sleep(time.Duration(v) * time.Millisecond)
-	case int8:
-		time.Sleep(time.Duration(v) * time.Millisecond)
-	case uint:
-		time.Sleep(time.Duration(v) * time.Millisecond)
-	case uint64:
-		time.Sleep(time.Duration(v) * time.Millisecond)
-	case uint32:
-		time.Sleep(time.Duration(v) * time.Millisecond)
-	case uint16:
-		time.Sleep(time.Duration(v) * time.Millisecond)
-	case uint8:
-		time.Sleep(time.Duration(v) * time.Millisecond)
-	default:
-		// Check if the argument is a task
-		task, ok := args[0].(*Task)
+	// Check if the argument is a task
+	task, ok := args[0].(*Task)
+	if !ok {
+		// Otherwise it is a duration in milliseconds
+		duration, ok := millisecondsOf(args[0])
 		if !ok {
 			return nil, WaitErrInvalidArgument
 		}
+		if len(args) > 1 {
+			return nil, WaitErrTooManyArgs
+		}
+		timer := time.NewTimer(duration)
+		defer timer.Stop()
+		select {
+		case <-timer.C:
+			return true, nil
+		case <-ctx.Done():
+			return nil, runtime_errors.NewContextError(ctx.Err())
+		}
+	}
+
+	var timeout time.Duration
+	if len(args) == 2 {
+		if timeout, ok = millisecondsOf(args[1]); !ok || timeout <= 0 {
+			return nil, WaitErrInvalidTimeout
+		}
+	}
 
-		// Wait for the task to complete and return its result
-		result, err := task.Await()
-		if err != nil {
-			return []interface{}{false, err.Error()}, nil
+	// Wait for the task to complete and return its result. A timeout is
+	// reported like an error of the task.
+	result, err := awaitTask(ctx, task, timeout, WaitErrTimeout)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, err
 		}
-		return []interface{}{true, result}, nil
+		return []interface{}{false, runtime_errors.ToErrorValue(err)}, nil
 	}
-	return true, nil
+	return []interface{}{true, result}, nil
 }
 
 func init() {
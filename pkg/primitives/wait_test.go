@@ -6,9 +6,12 @@ import (
 	"time"
 
 	"github.com/hyperifyio/gnd/pkg/parsers"
+	"github.com/hyperifyio/gnd/pkg/runtime_errors"
 	"github.com/stretchr/testify/assert"
 )
 
+var errTaskFailed = errors.New("task failed")
+
 func TestWait_Execute(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -33,11 +36,21 @@ func TestWait_Execute(t *testing.T) {
 			name: "too many arguments",
 			args: []interface{}{
 				NewTask([]*parsers.Instruction{{Opcode: "test"}}, nil),
+				100.0,
 				"extra",
 			},
 			wantErr:     true,
 			errContains: WaitErrTooManyArgs.Error(),
 		},
+		{
+			name: "invalid timeout",
+			args: []interface{}{
+				NewTask([]*parsers.Instruction{{Opcode: "test"}}, nil),
+				"extra",
+			},
+			wantErr:     true,
+			errContains: WaitErrInvalidTimeout.Error(),
+		},
 		{
 			name:       "numeric duration",
 			args:       []interface{}{200.0},
@@ -59,11 +72,11 @@ func TestWait_Execute(t *testing.T) {
 			args: []interface{}{
 				func() *Task {
 					task := NewTask([]*parsers.Instruction{{Opcode: "test"}}, nil)
-					task.SetError(errors.New("task failed"))
+					task.SetError(errTaskFailed)
 					return task
 				}(),
 			},
-			wantResult: []interface{}{false, "task failed"},
+			wantResult: []interface{}{false, runtime_errors.ToErrorValue(errTaskFailed)},
 		},
 	}
 
@@ -147,11 +160,11 @@ func TestWait_Execute_TaskStates(t *testing.T) {
 				task := NewTask([]*parsers.Instruction{{Opcode: "test"}}, nil)
 				go func() {
 					time.Sleep(50 * time.Millisecond)
-					task.SetError(errors.New("task failed"))
+					task.SetError(errTaskFailed)
 				}()
 				return task
 			}(),
-			wantResult: []interface{}{false, "task failed"},
+			wantResult: []interface{}{false, runtime_errors.ToErrorValue(errTaskFailed)},
 		},
 	}
 
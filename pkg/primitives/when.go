@@ -0,0 +1,53 @@
+package primitives
+
+import (
+	"errors"
+
+	"github.com/hyperifyio/gnd/pkg/parsers"
+	"github.com/hyperifyio/gnd/pkg/primitive_services"
+	"github.com/hyperifyio/gnd/pkg/primitive_types"
+)
+
+var WhenRequiresRoutineError = errors.New("when: requires a condition and a routine")
+var WhenRoutineInvalidError = errors.New("when: routine must be an instruction array or instruction")
+
+// When represents the when primitive
+type When struct{}
+
+var _ primitive_types.Primitive = &When{}
+var _ primitive_types.BlockSuccessResultHandler = &When{}
+
+func (w *When) Name() string {
+	return "/gnd/when"
+}
+
+// Execute returns an ExecResult for the routine if the condition is truthy,
+// and nil otherwise
+func (w *When) Execute(args []interface{}) (interface{}, error) {
+	return executeConditional(args, true, WhenRequiresRoutineError, WhenRoutineInvalidError)
+}
+
+// HandleBlockSuccessResult runs the routine chosen by Execute
+func (w *When) HandleBlockSuccessResult(result interface{}, i primitive_types.Interpreter, destination *parsers.PropertyRef, _ []*parsers.Instruction) (interface{}, error) {
+	return HandleRoutineResult(i, destination, result)
+}
+
+// executeConditional implements when and unless: the routine runs with the
+// remaining arguments when the truthiness of the condition equals runWhen
+func executeConditional(args []interface{}, runWhen bool, requiresErr, invalidErr error) (interface{}, error) {
+	if len(args) < 2 {
+		return nil, requiresErr
+	}
+	routine, ok := ToRoutine(args[1])
+	if !ok {
+		return nil, invalidErr
+	}
+	if Truthy(args[0]) != runWhen {
+		return nil, nil
+	}
+	return NewExecResult(routine, args[2:]), nil
+}
+
+func init() {
+	primitive_services.RegisterPrimitive(&When{})
+}
@@ -0,0 +1,26 @@
+package primitives_test
+
+import (
+	"testing"
+
+	"github.com/hyperifyio/gnd/pkg/primitives"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWhen(t *testing.T) {
+	slots := map[string]interface{}{"r": concatRoutine()}
+
+	got, err := runBlock(t, slots, `$result when true $r "a" "b"`)
+	assert.NoError(t, err)
+	assert.Equal(t, "ab", got)
+
+	got, err = runBlock(t, slots, `$result when 0 $r "a" "b"`)
+	assert.NoError(t, err)
+	assert.Nil(t, got)
+
+	_, err = runBlock(t, slots, `$result when true "not a routine"`)
+	assert.ErrorIs(t, err, primitives.WhenRoutineInvalidError)
+
+	_, err = (&primitives.When{}).Execute([]interface{}{true})
+	assert.ErrorIs(t, err, primitives.WhenRequiresRoutineError)
+}
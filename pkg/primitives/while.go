@@ -0,0 +1,90 @@
+package primitives
+
+import (
+	"errors"
+
+	"github.com/hyperifyio/gnd/pkg/parsers"
+	"github.com/hyperifyio/gnd/pkg/primitive_services"
+	"github.com/hyperifyio/gnd/pkg/primitive_types"
+)
+
+var WhileRequiresRoutinesError = errors.New("while: requires a condition routine and a body routine")
+var WhileRoutineInvalidError = errors.New("while: condition and body must be instruction arrays or instructions")
+
+// While represents the while primitive
+type While struct{}
+
+var _ primitive_types.Primitive = &While{}
+var _ primitive_types.BlockSuccessResultHandler = &While{}
+
+func (w *While) Name() string {
+	return "/gnd/while"
+}
+
+// Execute validates the arguments and returns a WhileResult for the
+// interpreter to handle
+func (w *While) Execute(args []interface{}) (interface{}, error) {
+	if len(args) < 2 {
+		return nil, WhileRequiresRoutinesError
+	}
+	condition, ok := ToRoutine(args[0])
+	if !ok {
+		return nil, WhileRoutineInvalidError
+	}
+	body, ok := ToRoutine(args[1])
+	if !ok {
+		return nil, WhileRoutineInvalidError
+	}
+	return NewWhileResult(condition, body, args[2:]), nil
+}
+
+// HandleBlockSuccessResult runs the loop. The state starts as the array of
+// the remaining arguments. On each iteration the condition routine runs with
+// the state as its input, and if its result is truthy, the body routine runs
+// with the state and its result becomes the new state. The final state is
+// the result.
+func (w *While) HandleBlockSuccessResult(result interface{}, i primitive_types.Interpreter, destination *parsers.PropertyRef, _ []*parsers.Instruction) (interface{}, error) {
+	whileResult, ok := GetWhileResult(result)
+	if !ok {
+		return result, nil
+	}
+
+	var state interface{} = whileResult.Args
+	for iteration := 1; ; iteration++ {
+		cond, err := runLoopRoutine(i, whileResult.Condition, state)
+		if err != nil {
+			return nil, err
+		}
+		if !Truthy(cond) {
+			break
+		}
+		if err := checkLoopIterations("while", iteration); err != nil {
+			return nil, err
+		}
+
+		i.LogDebug("[/gnd/while]: HandleBlockSuccessResult: iteration %d", iteration)
+		state, err = runLoopRoutine(i, whileResult.Body, state)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := i.SetSlot(destination.Name, state); err != nil {
+		return nil, err
+	}
+	return state, nil
+}
+
+// runLoopRoutine executes routine in a child interpreter with state as its
+// input. An array state is passed as the argument list, like exec does.
+func runLoopRoutine(i primitive_types.Interpreter, routine []*parsers.Instruction, state interface{}) (interface{}, error) {
+	args, ok := state.([]interface{})
+	if !ok {
+		args = []interface{}{state}
+	}
+	return HandleExecResult(i, NewExecResult(routine, args))
+}
+
+func init() {
+	primitive_services.RegisterPrimitive(&While{})
+}
@@ -0,0 +1,38 @@
+package primitives
+
+import (
+	"fmt"
+
+	"github.com/hyperifyio/gnd/pkg/parsers"
+)
+
+// WhileResult represents a request to execute a routine while a condition
+// routine returns a truthy value
+type WhileResult struct {
+	// Condition is the instruction array that decides whether to continue
+	Condition []*parsers.Instruction
+	// Body is the instruction array executed on each iteration
+	Body []*parsers.Instruction
+	// Args are the initial input of the loop
+	Args []interface{}
+}
+
+// String returns a string representation of the WhileResult
+func (w *WhileResult) String() string {
+	return fmt.Sprintf("WhileResult{condition: %v, body: %v, args: %v}", w.Condition, w.Body, w.Args)
+}
+
+// NewWhileResult creates a new WhileResult
+func NewWhileResult(condition, body []*parsers.Instruction, args []interface{}) *WhileResult {
+	return &WhileResult{
+		Condition: condition,
+		Body:      body,
+		Args:      args,
+	}
+}
+
+// GetWhileResult extracts the WhileResult from a value if it is one
+func GetWhileResult(v interface{}) (*WhileResult, bool) {
+	result, ok := v.(*WhileResult)
+	return result, ok
+}
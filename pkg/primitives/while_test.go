@@ -0,0 +1,46 @@
+package primitives_test
+
+import (
+	"testing"
+
+	"github.com/hyperifyio/gnd/pkg/parsers"
+	"github.com/hyperifyio/gnd/pkg/primitives"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWhile(t *testing.T) {
+	condition, err := parsers.ParseInstructionLines("cond", "$done eq _ [\"x\" \"x\" \"x\"]\nselect $done 0 1\n")
+	assert.NoError(t, err)
+	body, err := parsers.ParseInstructionLines("body", "concat _ \"x\"\n")
+	assert.NoError(t, err)
+	never, err := parsers.ParseInstructionLines("never", "bool 0\n")
+	assert.NoError(t, err)
+	forever, err := parsers.ParseInstructionLines("forever", "bool 1\n")
+	assert.NoError(t, err)
+
+	slots := map[string]interface{}{
+		"cond":    condition,
+		"body":    body,
+		"never":   never,
+		"forever": forever,
+	}
+
+	got, err := runBlock(t, slots, `$result while $cond $body`)
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{"x", "x", "x"}, got)
+
+	got, err = runBlock(t, slots, `$result while $never $body "a"`)
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{"a"}, got)
+
+	_, err = runBlock(t, slots, `$result while $cond`)
+	assert.ErrorIs(t, err, primitives.WhileRequiresRoutinesError)
+
+	_, err = runBlock(t, slots, `$result while $cond "body"`)
+	assert.ErrorIs(t, err, primitives.WhileRoutineInvalidError)
+
+	defer func(max int) { primitives.MaxLoopIterations = max }(primitives.MaxLoopIterations)
+	primitives.MaxLoopIterations = 5
+	_, err = runBlock(t, slots, `$result while $forever $body`)
+	assert.ErrorIs(t, err, primitives.LoopMaxIterationsError)
+}
@@ -0,0 +1,73 @@
+package primitives
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/hyperifyio/gnd/pkg/parsers"
+	"github.com/hyperifyio/gnd/pkg/primitive_services"
+	"github.com/hyperifyio/gnd/pkg/primitive_types"
+)
+
+var (
+	WriteFileRequiresArgumentsError = errors.New("write-file: requires a path and content")
+	WriteFileContentInvalidError    = errors.New("write-file: content must be a string or bytes")
+)
+
+// WriteFile represents the write-file primitive
+type WriteFile struct{}
+
+var _ primitive_types.Primitive = &WriteFile{}
+var _ primitive_types.BlockSuccessResultHandler = &WriteFile{}
+
+func (p *WriteFile) Name() string {
+	return "/gnd/write-file"
+}
+
+// Execute validates the arguments and returns a FileRequest for the
+// interpreter to handle
+func (p *WriteFile) Execute(args []interface{}) (interface{}, error) {
+	if len(args) != 2 {
+		return nil, WriteFileRequiresArgumentsError
+	}
+	switch args[1].(type) {
+	case string, []byte:
+	default:
+		return nil, WriteFileContentInvalidError
+	}
+	return NewFileRequest("write-file", args)
+}
+
+// HandleBlockSuccessResult writes the content, replacing the file if it
+// exists and creating missing parent directories. The result is the path.
+func (p *WriteFile) HandleBlockSuccessResult(result interface{}, i primitive_types.Interpreter, destination *parsers.PropertyRef, _ []*parsers.Instruction) (interface{}, error) {
+	request, ok := GetFileRequest(result)
+	if !ok {
+		return result, nil
+	}
+	path, err := ResolveFilePath(i.GetScriptDir(), request.Path)
+	if err != nil {
+		return nil, fmt.Errorf("write-file: %w", err)
+	}
+
+	var content []byte
+	switch v := request.Args[0].(type) {
+	case string:
+		content = []byte(v)
+	case []byte:
+		content = v
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, fmt.Errorf("write-file: %w", err)
+	}
+	if err := os.WriteFile(path, content, 0644); err != nil {
+		return nil, fmt.Errorf("write-file: %w", err)
+	}
+	return HandleRoutineResult(i, destination, request.Path)
+}
+
+func init() {
+	primitive_services.RegisterPrimitive(&WriteFile{})
+}
@@ -0,0 +1,33 @@
+package primitives_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hyperifyio/gnd/pkg/primitives"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriteFile(t *testing.T) {
+	dir := t.TempDir()
+
+	got, err := runBlockIn(t, dir, nil, `$result write-file "out/summary.txt" "short"`)
+	assert.NoError(t, err)
+	assert.Equal(t, "out/summary.txt", got)
+	content, err := os.ReadFile(filepath.Join(dir, "out", "summary.txt"))
+	assert.NoError(t, err)
+	assert.Equal(t, "short", string(content))
+
+	_, err = runBlockIn(t, dir, map[string]interface{}{"data": []byte{1, 2}}, `$result write-file "data.bin" $data`)
+	assert.NoError(t, err)
+	content, err = os.ReadFile(filepath.Join(dir, "data.bin"))
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{1, 2}, content)
+
+	_, err = runBlockIn(t, dir, nil, `$result write-file "../escape.txt" "x"`)
+	assert.ErrorIs(t, err, primitives.FileOutsideRootsError)
+
+	_, err = runBlockIn(t, dir, nil, `$result write-file "n.txt" 1`)
+	assert.ErrorIs(t, err, primitives.WriteFileContentInvalidError)
+}
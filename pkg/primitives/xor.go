@@ -0,0 +1,38 @@
+package primitives
+
+import (
+	"errors"
+
+	"github.com/hyperifyio/gnd/pkg/primitive_services"
+	"github.com/hyperifyio/gnd/pkg/primitive_types"
+)
+
+var XorRequiresArgumentsError = errors.New("xor: requires at least two arguments")
+
+// Xor represents the xor primitive
+type Xor struct{}
+
+var _ primitive_types.Primitive = &Xor{}
+
+func (p *Xor) Name() string {
+	return "/gnd/xor"
+}
+
+// Execute returns true if an odd number of the arguments are true, using the
+// truthiness rules of bool. With two arguments, this means exactly one.
+func (p *Xor) Execute(args []interface{}) (interface{}, error) {
+	if len(args) < 2 {
+		return nil, XorRequiresArgumentsError
+	}
+	result := false
+	for _, arg := range args {
+		if Truthy(arg) {
+			result = !result
+		}
+	}
+	return result, nil
+}
+
+func init() {
+	primitive_services.RegisterPrimitive(&Xor{})
+}
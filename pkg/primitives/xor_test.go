@@ -0,0 +1,26 @@
+package primitives
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestXor(t *testing.T) {
+	for _, tc := range []struct {
+		args []interface{}
+		want bool
+	}{
+		{[]interface{}{true, false}, true},
+		{[]interface{}{true, int64(1)}, false},
+		{[]interface{}{"", nil}, false},
+		{[]interface{}{true, true, true}, true},
+	} {
+		got, err := (&Xor{}).Execute(tc.args)
+		assert.NoError(t, err, "%v", tc.args)
+		assert.Equal(t, tc.want, got, "%v", tc.args)
+	}
+
+	_, err := (&Xor{}).Execute([]interface{}{true})
+	assert.ErrorIs(t, err, XorRequiresArgumentsError)
+}
@@ -0,0 +1,130 @@
+package prompts
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/hyperifyio/gnd/pkg/loggers"
+)
+
+const (
+	OpenAIBackendName   = "openai"
+	LlamaCppBackendName = "llamacpp"
+	OllamaBackendName   = "ollama"
+	FakeBackendName     = "fake"
+)
+
+var (
+	PromptEmptyPromptError    = errors.New("empty prompt")
+	PromptEmptyApiKeyError    = errors.New("empty API key")
+	PromptNoResponseError     = errors.New("no response from API")
+	PromptUnknownBackendError = errors.New("unknown prompt backend")
+)
+
+// CompletionRequest is a backend independent request for a single completion
+type CompletionRequest struct {
+	Prompt      string  `json:"prompt"`
+	Model       string  `json:"model"`
+	Temperature float64 `json:"temperature"`
+	MaxTokens   int     `json:"max_tokens"`
+}
+
+// Backend sends prompts to a language model
+type Backend interface {
+	Complete(ctx context.Context, req *CompletionRequest) (string, error)
+}
+
+// PromptClient defines the interface for LLM operations
+type PromptClient interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// DefaultBaseURL returns the usual local address of a backend's server
+func DefaultBaseURL(backend string) string {
+	switch backend {
+	case LlamaCppBackendName:
+		return "http://localhost:8080"
+	case OllamaBackendName:
+		return "http://localhost:11434"
+	default:
+		return "http://localhost:18080/v1"
+	}
+}
+
+// NewBackend creates the backend selected by config.Backend, wrapped in a
+// CachingBackend unless the prompt cache is off
+func NewBackend(config PromptConfig) (Backend, error) {
+	var backend Backend
+	client := &http.Client{Timeout: config.Timeout}
+	switch config.Backend {
+	case OpenAIBackendName, "":
+		backend = NewOpenAIBackend(client, config.BaseURL, config.APIKey)
+	case LlamaCppBackendName:
+		backend = NewLlamaCppBackend(client, config.BaseURL)
+	case OllamaBackendName:
+		backend = NewOllamaBackend(client, config.BaseURL)
+	case FakeBackendName:
+		backend = NewFakeBackend(config.FakeFile)
+	default:
+		return nil, fmt.Errorf("%w: %q", PromptUnknownBackendError, config.Backend)
+	}
+
+	if config.CacheMode == CacheOff || config.CacheMode == "" {
+		return backend, nil
+	}
+	dir := config.CacheDir
+	if dir == "" {
+		dir = DefaultCacheDir
+	}
+	return NewCachingBackend(backend, config.Backend, dir, config.CacheMode), nil
+}
+
+// postJSON sends body as JSON to url and decodes the JSON response into out
+func postJSON(ctx context.Context, client PromptClient, url string, headers map[string]string, body interface{}, out interface{}) error {
+	jsonBody, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	loggers.Printf(loggers.Debug, "LLM Request:")
+	loggers.Printf(loggers.Debug, "URL: %s", url)
+	loggers.Printf(loggers.Debug, "Body: %s", string(jsonBody))
+
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(jsonBody))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response: %w", err)
+	}
+
+	loggers.Printf(loggers.Debug, "LLM Response:")
+	loggers.Printf(loggers.Debug, "Status: %s", resp.Status)
+	loggers.Printf(loggers.Debug, "Body: %s", string(respBody))
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("API error: %s", respBody)
+	}
+
+	if err := json.Unmarshal(respBody, out); err != nil {
+		return fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+	return nil
+}
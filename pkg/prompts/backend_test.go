@@ -0,0 +1,41 @@
+package prompts
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewBackend(t *testing.T) {
+	tests := []struct {
+		backend string
+		want    Backend
+	}{
+		{"", &OpenAIBackend{}},
+		{OpenAIBackendName, &OpenAIBackend{}},
+		{LlamaCppBackendName, &LlamaCppBackend{}},
+		{OllamaBackendName, &OllamaBackend{}},
+		{FakeBackendName, &FakeBackend{}},
+	}
+	for _, tt := range tests {
+		config := DefaultConfig()
+		config.Backend = tt.backend
+		backend, err := NewBackend(config)
+		assert.NoError(t, err, tt.backend)
+		assert.IsType(t, tt.want, backend, tt.backend)
+	}
+
+	config := DefaultConfig()
+	config.CacheMode = CacheReplay
+	backend, err := NewBackend(config)
+	assert.NoError(t, err)
+	if assert.IsType(t, &CachingBackend{}, backend) {
+		assert.Equal(t, DefaultCacheDir, backend.(*CachingBackend).Dir)
+		assert.IsType(t, &OpenAIBackend{}, backend.(*CachingBackend).Backend)
+	}
+
+	config = DefaultConfig()
+	config.Backend = "gpt-on-a-stick"
+	_, err = NewBackend(config)
+	assert.ErrorIs(t, err, PromptUnknownBackendError)
+}
@@ -0,0 +1,187 @@
+package prompts
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/hyperifyio/gnd/pkg/loggers"
+)
+
+// CacheMode selects how CachingBackend uses its cassettes
+type CacheMode string
+
+const (
+	// CacheOff disables the prompt cache
+	CacheOff CacheMode = "off"
+	// CacheRecord always calls the backend and overwrites the cassette
+	CacheRecord CacheMode = "record"
+	// CacheReplay replays a cassette when one exists and records it otherwise
+	CacheReplay CacheMode = "replay"
+	// CacheReplayOrFail replays cassettes and fails instead of calling the backend
+	CacheReplayOrFail CacheMode = "replay-or-fail"
+)
+
+// DefaultCacheDir is the cassette directory used when only a mode is configured
+const DefaultCacheDir = ".gnd-prompts"
+
+var (
+	PromptInvalidCacheModeError = errors.New("invalid prompt cache mode")
+	PromptCacheMissError        = errors.New("prompt cache: no recorded reply")
+)
+
+// ParseCacheMode parses a cache mode name. An empty name means CacheOff.
+func ParseCacheMode(name string) (CacheMode, error) {
+	switch mode := CacheMode(name); mode {
+	case "":
+		return CacheOff, nil
+	case CacheOff, CacheRecord, CacheReplay, CacheReplayOrFail:
+		return mode, nil
+	default:
+		return "", fmt.Errorf("%w: %q", PromptInvalidCacheModeError, name)
+	}
+}
+
+// CacheKey identifies a completion. Everything that may change the reply of
+// the model is part of the key.
+type CacheKey struct {
+	Backend     string  `json:"backend"`
+	Model       string  `json:"model"`
+	Temperature float64 `json:"temperature"`
+	MaxTokens   int     `json:"max_tokens"`
+	Prompt      string  `json:"prompt"`
+}
+
+// Hash returns the hex encoded sha256 of the key
+func (k CacheKey) Hash() string {
+	data, _ := json.Marshal(k)
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// Cassette is a recorded completion as stored in the cache directory
+type Cassette struct {
+	CacheKey
+	Reply string `json:"reply"`
+}
+
+// CachingBackend records completions of another backend as cassette files
+// named by the hash of their CacheKey, and replays them
+type CachingBackend struct {
+	Backend Backend
+	// Name is the name of the wrapped backend, used in the cache key
+	Name string
+	Dir  string
+	Mode CacheMode
+}
+
+var _ Backend = &CachingBackend{}
+
+// NewCachingBackend wraps backend with a cassette cache in dir
+func NewCachingBackend(backend Backend, name, dir string, mode CacheMode) *CachingBackend {
+	return &CachingBackend{
+		Backend: backend,
+		Name:    name,
+		Dir:     dir,
+		Mode:    mode,
+	}
+}
+
+// CassettePath returns the path of the cassette for req
+func (b *CachingBackend) CassettePath(req *CompletionRequest) string {
+	return filepath.Join(b.Dir, b.key(req).Hash()+".json")
+}
+
+// Complete replays or records the completion depending on the cache mode
+func (b *CachingBackend) Complete(ctx context.Context, req *CompletionRequest) (string, error) {
+	path := b.CassettePath(req)
+
+	if b.Mode == CacheReplay || b.Mode == CacheReplayOrFail {
+		cassette, err := readCassette(path)
+		if err == nil {
+			loggers.Printf(loggers.Debug, "[%s]: CachingBackend: replaying", path)
+			return cassette.Reply, nil
+		}
+		if !errors.Is(err, fs.ErrNotExist) {
+			return "", err
+		}
+		if b.Mode == CacheReplayOrFail {
+			return "", fmt.Errorf("%w: %s", PromptCacheMissError, path)
+		}
+	}
+
+	reply, err := b.Backend.Complete(ctx, req)
+	if err != nil {
+		return "", err
+	}
+
+	if b.Mode != CacheOff {
+		if err := writeCassette(path, &Cassette{CacheKey: b.key(req), Reply: reply}); err != nil {
+			return "", err
+		}
+		loggers.Printf(loggers.Debug, "[%s]: CachingBackend: recorded", path)
+	}
+	return reply, nil
+}
+
+func (b *CachingBackend) key(req *CompletionRequest) CacheKey {
+	return CacheKey{
+		Backend:     b.Name,
+		Model:       req.Model,
+		Temperature: req.Temperature,
+		MaxTokens:   req.MaxTokens,
+		Prompt:      req.Prompt,
+	}
+}
+
+// readCassette reads a cassette file
+func readCassette(path string) (*Cassette, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var cassette Cassette
+	if err := json.Unmarshal(content, &cassette); err != nil {
+		return nil, fmt.Errorf("[%s]: prompt cache: invalid cassette: %w", path, err)
+	}
+	return &cassette, nil
+}
+
+// writeCassette writes a cassette file through a temporary file so that
+// concurrent readers never see a partial cassette
+func writeCassette(path string, cassette *Cassette) error {
+	content, err := json.MarshalIndent(cassette, "", "  ")
+	if err != nil {
+		return fmt.Errorf("[%s]: prompt cache: %w", path, err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("[%s]: prompt cache: %w", path, err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".cassette-*")
+	if err != nil {
+		return fmt.Errorf("[%s]: prompt cache: %w", path, err)
+	}
+	defer os.Remove(tmp.Name())
+	if err := tmp.Chmod(0644); err != nil {
+		tmp.Close()
+		return fmt.Errorf("[%s]: prompt cache: %w", path, err)
+	}
+	if _, err := tmp.Write(append(content, '\n')); err != nil {
+		tmp.Close()
+		return fmt.Errorf("[%s]: prompt cache: %w", path, err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("[%s]: prompt cache: %w", path, err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("[%s]: prompt cache: %w", path, err)
+	}
+	return nil
+}
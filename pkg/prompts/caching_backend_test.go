@@ -0,0 +1,108 @@
+package prompts
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// countingBackend replies with a fixed text and counts the calls
+type countingBackend struct {
+	reply string
+	calls int
+}
+
+func (b *countingBackend) Complete(_ context.Context, req *CompletionRequest) (string, error) {
+	b.calls++
+	if b.reply == "" {
+		return "", errors.New("backend unavailable")
+	}
+	return b.reply, nil
+}
+
+func TestParseCacheMode(t *testing.T) {
+	for name, want := range map[string]CacheMode{
+		"":               CacheOff,
+		"off":            CacheOff,
+		"record":         CacheRecord,
+		"replay":         CacheReplay,
+		"replay-or-fail": CacheReplayOrFail,
+	} {
+		mode, err := ParseCacheMode(name)
+		assert.NoError(t, err, name)
+		assert.Equal(t, want, mode, name)
+	}
+
+	_, err := ParseCacheMode("rewind")
+	assert.ErrorIs(t, err, PromptInvalidCacheModeError)
+}
+
+func TestCacheKeyHash(t *testing.T) {
+	key := CacheKey{Backend: "openai", Model: "bitnet", Temperature: 0.7, MaxTokens: 1000, Prompt: "hi"}
+	assert.Equal(t, key.Hash(), key.Hash())
+	assert.Len(t, key.Hash(), 64)
+
+	for _, other := range []CacheKey{
+		{Backend: "ollama", Model: "bitnet", Temperature: 0.7, MaxTokens: 1000, Prompt: "hi"},
+		{Backend: "openai", Model: "llama3", Temperature: 0.7, MaxTokens: 1000, Prompt: "hi"},
+		{Backend: "openai", Model: "bitnet", Temperature: 0, MaxTokens: 1000, Prompt: "hi"},
+		{Backend: "openai", Model: "bitnet", Temperature: 0.7, MaxTokens: 10, Prompt: "hi"},
+		{Backend: "openai", Model: "bitnet", Temperature: 0.7, MaxTokens: 1000, Prompt: "hi!"},
+	} {
+		assert.NotEqual(t, key.Hash(), other.Hash(), other)
+	}
+}
+
+func TestCachingBackend(t *testing.T) {
+	dir := t.TempDir()
+	ctx := context.Background()
+	req := &CompletionRequest{Prompt: "Are you there?", Model: "bitnet", Temperature: 0.7, MaxTokens: 100}
+	inner := &countingBackend{reply: "yes"}
+
+	// record always calls the backend
+	recorder := NewCachingBackend(inner, "openai", dir, CacheRecord)
+	for i := 0; i < 2; i++ {
+		reply, err := recorder.Complete(ctx, req)
+		assert.NoError(t, err)
+		assert.Equal(t, "yes", reply)
+	}
+	assert.Equal(t, 2, inner.calls)
+
+	cassette, err := readCassette(recorder.CassettePath(req))
+	assert.NoError(t, err)
+	assert.Equal(t, "Are you there?", cassette.Prompt)
+	assert.Equal(t, "yes", cassette.Reply)
+
+	// replay-or-fail never calls the backend
+	inner = &countingBackend{}
+	player := NewCachingBackend(inner, "openai", dir, CacheReplayOrFail)
+	reply, err := player.Complete(ctx, req)
+	assert.NoError(t, err)
+	assert.Equal(t, "yes", reply)
+
+	_, err = player.Complete(ctx, &CompletionRequest{Prompt: "Are you there?", Model: "llama3"})
+	assert.ErrorIs(t, err, PromptCacheMissError)
+	assert.Equal(t, 0, inner.calls)
+
+	// replay records missing cassettes
+	inner = &countingBackend{reply: "maybe"}
+	player = NewCachingBackend(inner, "openai", dir, CacheReplay)
+	other := &CompletionRequest{Prompt: "Who are you?"}
+	for i := 0; i < 2; i++ {
+		reply, err = player.Complete(ctx, other)
+		assert.NoError(t, err)
+		assert.Equal(t, "maybe", reply)
+	}
+	assert.Equal(t, 1, inner.calls)
+
+	// Backend errors are not recorded
+	inner = &countingBackend{}
+	player = NewCachingBackend(inner, "openai", dir, CacheReplay)
+	_, err = player.Complete(ctx, &CompletionRequest{Prompt: "Anyone?"})
+	assert.Error(t, err)
+	_, err = os.Stat(player.CassettePath(&CompletionRequest{Prompt: "Anyone?"}))
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
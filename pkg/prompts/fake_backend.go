@@ -0,0 +1,66 @@
+package prompts
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+var FakeBackendNoResponseError = errors.New("fake backend: no response for prompt")
+
+// FakeResponses is the content of a fake backend response file:
+//
+//	{
+//	  "responses": { "prompt text": "reply" },
+//	  "default": "reply for any other prompt"
+//	}
+//
+// Prompts are matched after trimming surrounding whitespace.
+type FakeResponses struct {
+	Responses map[string]string `json:"responses"`
+	Default   *string           `json:"default"`
+}
+
+// FakeBackend replies from a response file without contacting any server.
+// It is deterministic and meant for tests and offline development.
+type FakeBackend struct {
+	Path string
+}
+
+var _ Backend = &FakeBackend{}
+
+// NewFakeBackend creates a backend that replies from the file at path
+func NewFakeBackend(path string) *FakeBackend {
+	return &FakeBackend{Path: path}
+}
+
+// Complete returns the reply recorded for the prompt. The file is read on
+// every call so that it can be edited while a pipeline is being developed.
+func (b *FakeBackend) Complete(_ context.Context, req *CompletionRequest) (string, error) {
+	if req.Prompt == "" {
+		return "", PromptEmptyPromptError
+	}
+
+	content, err := os.ReadFile(b.Path)
+	if err != nil {
+		return "", fmt.Errorf("fake backend: failed to read responses: %w", err)
+	}
+	var responses FakeResponses
+	if err := json.Unmarshal(content, &responses); err != nil {
+		return "", fmt.Errorf("fake backend: failed to parse %s: %w", b.Path, err)
+	}
+
+	prompt := strings.TrimSpace(req.Prompt)
+	for key, reply := range responses.Responses {
+		if strings.TrimSpace(key) == prompt {
+			return reply, nil
+		}
+	}
+	if responses.Default != nil {
+		return *responses.Default, nil
+	}
+	return "", fmt.Errorf("%w: %q", FakeBackendNoResponseError, req.Prompt)
+}
@@ -0,0 +1,31 @@
+package prompts
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFakeBackendComplete(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "responses.json")
+	assert.NoError(t, os.WriteFile(path, []byte(`{"responses": {"Are you there?": "yes"}}`), 0644))
+
+	backend := NewFakeBackend(path)
+	reply, err := backend.Complete(context.Background(), &CompletionRequest{Prompt: "  Are you there?\n"})
+	assert.NoError(t, err)
+	assert.Equal(t, "yes", reply)
+
+	_, err = backend.Complete(context.Background(), &CompletionRequest{Prompt: "Who are you?"})
+	assert.ErrorIs(t, err, FakeBackendNoResponseError)
+
+	assert.NoError(t, os.WriteFile(path, []byte(`{"responses": {}, "default": "no"}`), 0644))
+	reply, err = backend.Complete(context.Background(), &CompletionRequest{Prompt: "Who are you?"})
+	assert.NoError(t, err)
+	assert.Equal(t, "no", reply)
+
+	_, err = NewFakeBackend(filepath.Join(t.TempDir(), "missing.json")).Complete(context.Background(), &CompletionRequest{Prompt: "hi"})
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
@@ -0,0 +1,56 @@
+package prompts
+
+import (
+	"context"
+)
+
+// LlamaCppCompletionRequest is the request body of the llama.cpp server
+// /completion endpoint
+type LlamaCppCompletionRequest struct {
+	Prompt      string  `json:"prompt"`
+	Temperature float64 `json:"temperature"`
+	NPredict    int     `json:"n_predict"`
+	Stream      bool    `json:"stream"`
+}
+
+// LlamaCppCompletionResponse is the response body of the llama.cpp server
+// /completion endpoint
+type LlamaCppCompletionResponse struct {
+	Content string `json:"content"`
+}
+
+// LlamaCppBackend talks to the native /completion API of the llama.cpp
+// server. The server runs a single model, so the model name is not sent.
+type LlamaCppBackend struct {
+	Client  PromptClient
+	BaseURL string
+}
+
+var _ Backend = &LlamaCppBackend{}
+
+// NewLlamaCppBackend creates a backend for the llama.cpp server at baseURL
+func NewLlamaCppBackend(client PromptClient, baseURL string) *LlamaCppBackend {
+	return &LlamaCppBackend{
+		Client:  client,
+		BaseURL: baseURL,
+	}
+}
+
+// Complete sends the prompt as raw completion input
+func (b *LlamaCppBackend) Complete(ctx context.Context, req *CompletionRequest) (string, error) {
+	if req.Prompt == "" {
+		return "", PromptEmptyPromptError
+	}
+
+	body := LlamaCppCompletionRequest{
+		Prompt:      req.Prompt,
+		Temperature: req.Temperature,
+		NPredict:    req.MaxTokens,
+	}
+
+	var resp LlamaCppCompletionResponse
+	if err := postJSON(ctx, b.Client, b.BaseURL+"/completion", nil, body, &resp); err != nil {
+		return "", err
+	}
+	return resp.Content, nil
+}
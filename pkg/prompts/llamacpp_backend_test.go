@@ -0,0 +1,30 @@
+package prompts
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLlamaCppBackendComplete(t *testing.T) {
+	var got LlamaCppCompletionRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/completion", r.URL.Path)
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+		json.NewEncoder(w).Encode(map[string]interface{}{"content": " yes", "stop": true})
+	}))
+	defer server.Close()
+
+	backend := NewLlamaCppBackend(server.Client(), server.URL)
+	reply, err := backend.Complete(context.Background(), &CompletionRequest{Prompt: "Are you there?", Model: "ignored", Temperature: 0.2, MaxTokens: 16})
+	assert.NoError(t, err)
+	assert.Equal(t, " yes", reply)
+	assert.Equal(t, LlamaCppCompletionRequest{Prompt: "Are you there?", Temperature: 0.2, NPredict: 16}, got)
+
+	_, err = backend.Complete(context.Background(), &CompletionRequest{})
+	assert.ErrorIs(t, err, PromptEmptyPromptError)
+}
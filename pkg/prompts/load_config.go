@@ -0,0 +1,183 @@
+package prompts
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/hyperifyio/gnd/pkg/helpers"
+)
+
+// ConfigFile is the JSON format of the prompt configuration file
+type ConfigFile struct {
+	Backend     string   `json:"backend"`
+	BaseURL     string   `json:"base_url"`
+	APIKey      string   `json:"api_key"`
+	Model       string   `json:"model"`
+	Temperature *float64 `json:"temperature"`
+	MaxTokens   int      `json:"max_tokens"`
+	Timeout     string   `json:"timeout"`
+	FakeFile    string   `json:"fake_file"`
+	CacheDir    string   `json:"cache_dir"`
+	CacheMode   string   `json:"cache_mode"`
+}
+
+// CacheDirOverride and CacheModeOverride take precedence over the prompt
+// cache configuration when set, e.g. from command line flags
+var (
+	CacheDirOverride  = ""
+	CacheModeOverride = ""
+)
+
+// ConfigFilePath returns the path of the prompt configuration file: the value
+// of GND_PROMPT_CONFIG, or gnd/prompt.json in the user configuration
+// directory. The second return value is false if GND_PROMPT_CONFIG is unset,
+// meaning the file is optional.
+func ConfigFilePath() (string, bool) {
+	if path := helpers.GetEnv("GND_PROMPT_CONFIG", ""); path != "" {
+		return path, true
+	}
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", false
+	}
+	return filepath.Join(dir, "gnd", "prompt.json"), false
+}
+
+// LoadConfig returns the prompt configuration. DefaultConfig is overridden by
+// the configuration file (see ConfigFilePath) and then by the environment:
+//
+//	GND_PROMPT_BACKEND    openai, llamacpp, ollama or fake
+//	GND_PROMPT_URL        base URL of the server
+//	GND_PROMPT_MODEL      model name
+//	GND_PROMPT_FAKE_FILE  response file of the fake backend
+//	GND_PROMPT_CACHE_DIR  cassette directory of the prompt cache
+//	GND_PROMPT_CACHE_MODE off, record, replay or replay-or-fail
+//	OPENAI_API_KEY        API key of the openai backend
+//	OPENAI_API_URL        base URL of the openai backend
+//	OPENAI_MODEL          model name of the openai backend
+//
+// When no base URL is configured, the default of the selected backend is used.
+func LoadConfig() (PromptConfig, error) {
+	config := DefaultConfig()
+	config.BaseURL = ""
+
+	path, required := ConfigFilePath()
+	if path != "" {
+		if err := applyConfigFile(&config, path, required); err != nil {
+			return config, err
+		}
+	}
+
+	config.Backend = helpers.GetEnv("GND_PROMPT_BACKEND", config.Backend)
+	if config.Backend == OpenAIBackendName {
+		config.BaseURL = helpers.GetEnv("OPENAI_API_URL", config.BaseURL)
+		config.Model = helpers.GetEnv("OPENAI_MODEL", config.Model)
+		config.APIKey = helpers.GetEnv("OPENAI_API_KEY", config.APIKey)
+	}
+	config.BaseURL = helpers.GetEnv("GND_PROMPT_URL", config.BaseURL)
+	config.Model = helpers.GetEnv("GND_PROMPT_MODEL", config.Model)
+	config.FakeFile = helpers.GetEnv("GND_PROMPT_FAKE_FILE", config.FakeFile)
+	config.CacheDir = helpers.GetEnv("GND_PROMPT_CACHE_DIR", config.CacheDir)
+	if CacheDirOverride != "" {
+		config.CacheDir = CacheDirOverride
+	}
+
+	cacheMode := helpers.GetEnv("GND_PROMPT_CACHE_MODE", string(config.CacheMode))
+	if CacheModeOverride != "" {
+		cacheMode = CacheModeOverride
+	}
+	mode, err := ParseCacheMode(cacheMode)
+	if err != nil {
+		return config, err
+	}
+	config.CacheMode = mode
+
+	if config.BaseURL == "" {
+		config.BaseURL = DefaultBaseURL(config.Backend)
+	}
+	return config, nil
+}
+
+// LoadBackend loads the prompt configuration and creates the selected backend
+func LoadBackend() (Backend, PromptConfig, error) {
+	config, err := LoadConfig()
+	if err != nil {
+		return nil, config, err
+	}
+	backend, err := NewBackend(config)
+	if err != nil {
+		return nil, config, err
+	}
+	return backend, config, nil
+}
+
+// applyConfigFile overrides config with the values set in the file at path.
+// A missing file is ignored unless required is true.
+func applyConfigFile(config *PromptConfig, path string, required bool) error {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		if !required && errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+		return fmt.Errorf("[%s]: LoadConfig: failed to read: %w", path, err)
+	}
+
+	var file ConfigFile
+	if err := json.Unmarshal(content, &file); err != nil {
+		return fmt.Errorf("[%s]: LoadConfig: failed to parse: %w", path, err)
+	}
+
+	if file.Backend != "" {
+		config.Backend = file.Backend
+	}
+	if file.BaseURL != "" {
+		config.BaseURL = file.BaseURL
+	}
+	if file.APIKey != "" {
+		config.APIKey = file.APIKey
+	}
+	if file.Model != "" {
+		config.Model = file.Model
+	}
+	if file.Temperature != nil {
+		config.Temperature = *file.Temperature
+	}
+	if file.MaxTokens != 0 {
+		config.MaxTokens = file.MaxTokens
+	}
+	if file.Timeout != "" {
+		timeout, err := time.ParseDuration(file.Timeout)
+		if err != nil {
+			return fmt.Errorf("[%s]: LoadConfig: invalid timeout: %w", path, err)
+		}
+		config.Timeout = timeout
+	}
+	if file.FakeFile != "" {
+		config.FakeFile = resolveConfigPath(path, file.FakeFile)
+	}
+	if file.CacheDir != "" {
+		config.CacheDir = resolveConfigPath(path, file.CacheDir)
+	}
+	if file.CacheMode != "" {
+		mode, err := ParseCacheMode(file.CacheMode)
+		if err != nil {
+			return fmt.Errorf("[%s]: LoadConfig: %w", path, err)
+		}
+		config.CacheMode = mode
+	}
+	return nil
+}
+
+// resolveConfigPath resolves a path of the configuration file relative to
+// the directory of the file
+func resolveConfigPath(configPath, path string) string {
+	if filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(filepath.Dir(configPath), path)
+}
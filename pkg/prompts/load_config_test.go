@@ -0,0 +1,111 @@
+package prompts
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// clearConfigEnv isolates a test from the prompt configuration of the host
+func clearConfigEnv(t *testing.T) {
+	for _, key := range []string{"GND_PROMPT_BACKEND", "GND_PROMPT_URL", "GND_PROMPT_MODEL", "GND_PROMPT_FAKE_FILE", "GND_PROMPT_CACHE_DIR", "GND_PROMPT_CACHE_MODE", "OPENAI_API_KEY", "OPENAI_API_URL", "OPENAI_MODEL"} {
+		t.Setenv(key, "")
+	}
+	t.Setenv("GND_PROMPT_CONFIG", "")
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	t.Setenv("HOME", t.TempDir())
+}
+
+func TestLoadConfigDefaults(t *testing.T) {
+	clearConfigEnv(t)
+	config, err := LoadConfig()
+	assert.NoError(t, err)
+	assert.Equal(t, DefaultConfig(), config)
+}
+
+func TestLoadConfigEnvironment(t *testing.T) {
+	clearConfigEnv(t)
+	t.Setenv("GND_PROMPT_BACKEND", OllamaBackendName)
+	t.Setenv("GND_PROMPT_MODEL", "llama3")
+	t.Setenv("OPENAI_API_URL", "http://ignored")
+
+	config, err := LoadConfig()
+	assert.NoError(t, err)
+	assert.Equal(t, OllamaBackendName, config.Backend)
+	assert.Equal(t, DefaultBaseURL(OllamaBackendName), config.BaseURL)
+	assert.Equal(t, "llama3", config.Model)
+
+	t.Setenv("GND_PROMPT_BACKEND", "")
+	t.Setenv("OPENAI_API_KEY", "secret")
+	config, err = LoadConfig()
+	assert.NoError(t, err)
+	assert.Equal(t, "http://ignored", config.BaseURL)
+	assert.Equal(t, "secret", config.APIKey)
+}
+
+func TestLoadConfigFile(t *testing.T) {
+	clearConfigEnv(t)
+	dir := t.TempDir()
+	path := filepath.Join(dir, "prompt.json")
+	assert.NoError(t, os.WriteFile(path, []byte(`{
+		"backend": "fake",
+		"model": "tiny",
+		"temperature": 0,
+		"max_tokens": 64,
+		"timeout": "5s",
+		"fake_file": "responses.json",
+		"cache_dir": "cassettes",
+		"cache_mode": "replay"
+	}`), 0644))
+	t.Setenv("GND_PROMPT_CONFIG", path)
+
+	config, err := LoadConfig()
+	assert.NoError(t, err)
+	assert.Equal(t, FakeBackendName, config.Backend)
+	assert.Equal(t, "tiny", config.Model)
+	assert.Equal(t, 0.0, config.Temperature)
+	assert.Equal(t, 64, config.MaxTokens)
+	assert.Equal(t, 5*time.Second, config.Timeout)
+	assert.Equal(t, filepath.Join(dir, "responses.json"), config.FakeFile)
+	assert.Equal(t, filepath.Join(dir, "cassettes"), config.CacheDir)
+	assert.Equal(t, CacheReplay, config.CacheMode)
+
+	// The environment overrides the file
+	t.Setenv("GND_PROMPT_BACKEND", LlamaCppBackendName)
+	config, err = LoadConfig()
+	assert.NoError(t, err)
+	assert.Equal(t, LlamaCppBackendName, config.Backend)
+
+	// An explicitly configured file must exist
+	t.Setenv("GND_PROMPT_CONFIG", filepath.Join(dir, "missing.json"))
+	_, err = LoadConfig()
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestLoadConfigCache(t *testing.T) {
+	clearConfigEnv(t)
+	t.Setenv("GND_PROMPT_CACHE_MODE", "record")
+	t.Setenv("GND_PROMPT_CACHE_DIR", "env-cassettes")
+
+	config, err := LoadConfig()
+	assert.NoError(t, err)
+	assert.Equal(t, CacheRecord, config.CacheMode)
+	assert.Equal(t, "env-cassettes", config.CacheDir)
+	assert.True(t, config.RequiresApiKey())
+
+	// Overrides set from command line flags win over the environment
+	CacheDirOverride, CacheModeOverride = "flag-cassettes", "replay-or-fail"
+	defer func() { CacheDirOverride, CacheModeOverride = "", "" }()
+	config, err = LoadConfig()
+	assert.NoError(t, err)
+	assert.Equal(t, CacheReplayOrFail, config.CacheMode)
+	assert.Equal(t, "flag-cassettes", config.CacheDir)
+	assert.False(t, config.RequiresApiKey())
+
+	CacheModeOverride = "rewind"
+	_, err = LoadConfig()
+	assert.ErrorIs(t, err, PromptInvalidCacheModeError)
+}
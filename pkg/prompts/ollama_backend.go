@@ -0,0 +1,68 @@
+package prompts
+
+import (
+	"context"
+)
+
+// OllamaGenerateOptions holds the model parameters of an Ollama request
+type OllamaGenerateOptions struct {
+	Temperature float64 `json:"temperature"`
+	NumPredict  int     `json:"num_predict"`
+}
+
+// OllamaGenerateRequest is the request body of the Ollama /api/generate endpoint
+type OllamaGenerateRequest struct {
+	Model   string                `json:"model"`
+	Prompt  string                `json:"prompt"`
+	Stream  bool                  `json:"stream"`
+	Options OllamaGenerateOptions `json:"options"`
+}
+
+// OllamaGenerateResponse is the response body of the Ollama /api/generate
+// endpoint when streaming is disabled
+type OllamaGenerateResponse struct {
+	Model    string `json:"model"`
+	Response string `json:"response"`
+	Done     bool   `json:"done"`
+}
+
+// OllamaBackend talks to the /api/generate API of an Ollama server
+type OllamaBackend struct {
+	Client  PromptClient
+	BaseURL string
+}
+
+var _ Backend = &OllamaBackend{}
+
+// NewOllamaBackend creates a backend for the Ollama server at baseURL
+func NewOllamaBackend(client PromptClient, baseURL string) *OllamaBackend {
+	return &OllamaBackend{
+		Client:  client,
+		BaseURL: baseURL,
+	}
+}
+
+// Complete sends the prompt as a single non-streaming generate request
+func (b *OllamaBackend) Complete(ctx context.Context, req *CompletionRequest) (string, error) {
+	if req.Prompt == "" {
+		return "", PromptEmptyPromptError
+	}
+
+	body := OllamaGenerateRequest{
+		Model:  req.Model,
+		Prompt: req.Prompt,
+		Options: OllamaGenerateOptions{
+			Temperature: req.Temperature,
+			NumPredict:  req.MaxTokens,
+		},
+	}
+
+	var resp OllamaGenerateResponse
+	if err := postJSON(ctx, b.Client, b.BaseURL+"/api/generate", nil, body, &resp); err != nil {
+		return "", err
+	}
+	if !resp.Done {
+		return "", PromptNoResponseError
+	}
+	return resp.Response, nil
+}
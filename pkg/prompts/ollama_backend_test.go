@@ -0,0 +1,36 @@
+package prompts
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOllamaBackendComplete(t *testing.T) {
+	var got OllamaGenerateRequest
+	done := true
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/generate", r.URL.Path)
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+		json.NewEncoder(w).Encode(map[string]interface{}{"model": got.Model, "response": "yes", "done": done})
+	}))
+	defer server.Close()
+
+	backend := NewOllamaBackend(server.Client(), server.URL)
+	reply, err := backend.Complete(context.Background(), &CompletionRequest{Prompt: "Are you there?", Model: "llama3", Temperature: 0.1, MaxTokens: 32})
+	assert.NoError(t, err)
+	assert.Equal(t, "yes", reply)
+	assert.Equal(t, OllamaGenerateRequest{
+		Model:   "llama3",
+		Prompt:  "Are you there?",
+		Options: OllamaGenerateOptions{Temperature: 0.1, NumPredict: 32},
+	}, got)
+
+	done = false
+	_, err = backend.Complete(context.Background(), &CompletionRequest{Prompt: "Are you there?"})
+	assert.ErrorIs(t, err, PromptNoResponseError)
+}
@@ -0,0 +1,50 @@
+package prompts
+
+import (
+	"context"
+)
+
+// OpenAIBackend talks to an OpenAI compatible /chat/completions endpoint
+type OpenAIBackend struct {
+	Client  PromptClient
+	BaseURL string
+	APIKey  string
+}
+
+var _ Backend = &OpenAIBackend{}
+
+// NewOpenAIBackend creates a backend for the OpenAI compatible API at baseURL
+func NewOpenAIBackend(client PromptClient, baseURL, apiKey string) *OpenAIBackend {
+	return &OpenAIBackend{
+		Client:  client,
+		BaseURL: baseURL,
+		APIKey:  apiKey,
+	}
+}
+
+// Complete sends the prompt as a single user message
+func (b *OpenAIBackend) Complete(ctx context.Context, req *CompletionRequest) (string, error) {
+	if b.APIKey == "" {
+		return "", PromptEmptyApiKeyError
+	}
+	if req.Prompt == "" {
+		return "", PromptEmptyPromptError
+	}
+
+	body := ChatRequest{
+		Model:       req.Model,
+		Messages:    []Message{{Role: "user", Content: req.Prompt}},
+		Temperature: req.Temperature,
+		MaxTokens:   req.MaxTokens,
+	}
+	headers := map[string]string{"Authorization": "Bearer " + b.APIKey}
+
+	var chatResp ChatResponse
+	if err := postJSON(ctx, b.Client, b.BaseURL+"/chat/completions", headers, body, &chatResp); err != nil {
+		return "", err
+	}
+	if len(chatResp.Choices) == 0 {
+		return "", PromptNoResponseError
+	}
+	return chatResp.Choices[0].Message.Content, nil
+}
@@ -0,0 +1,56 @@
+package prompts
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOpenAIBackendComplete(t *testing.T) {
+	var got ChatRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/chat/completions", r.URL.Path)
+		assert.Equal(t, "Bearer test-key", r.Header.Get("Authorization"))
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"choices": []interface{}{
+				map[string]interface{}{
+					"message": map[string]interface{}{"role": "assistant", "content": "yes"},
+				},
+			},
+		})
+	}))
+	defer server.Close()
+
+	backend := NewOpenAIBackend(server.Client(), server.URL+"/v1", "test-key")
+	reply, err := backend.Complete(context.Background(), &CompletionRequest{Prompt: "Are you there?", Model: "bitnet", Temperature: 0.5, MaxTokens: 10})
+	assert.NoError(t, err)
+	assert.Equal(t, "yes", reply)
+	assert.Equal(t, ChatRequest{
+		Model:       "bitnet",
+		Messages:    []Message{{Role: "user", Content: "Are you there?"}},
+		Temperature: 0.5,
+		MaxTokens:   10,
+	}, got)
+}
+
+func TestOpenAIBackendErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(`{"error":"invalid key"}`))
+	}))
+	defer server.Close()
+
+	_, err := NewOpenAIBackend(server.Client(), server.URL, "").Complete(context.Background(), &CompletionRequest{Prompt: "hi"})
+	assert.ErrorIs(t, err, PromptEmptyApiKeyError)
+
+	_, err = NewOpenAIBackend(server.Client(), server.URL, "key").Complete(context.Background(), &CompletionRequest{})
+	assert.ErrorIs(t, err, PromptEmptyPromptError)
+
+	_, err = NewOpenAIBackend(server.Client(), server.URL, "key").Complete(context.Background(), &CompletionRequest{Prompt: "hi"})
+	assert.EqualError(t, err, `API error: {"error":"invalid key"}`)
+}
@@ -1,14 +1,8 @@
 package prompts
 
 import (
-	"bytes"
-	"encoding/json"
-	"fmt"
-	"io"
-	"net/http"
+	"context"
 	"time"
-
-	"github.com/hyperifyio/gnd/pkg/loggers"
 )
 
 // Message represents a chat message
@@ -39,106 +33,57 @@ type ChatResponse struct {
 	} `json:"choices"`
 }
 
-// PromptClient defines the interface for LLM operations
-type PromptClient interface {
-	Do(req *http.Request) (*http.Response, error)
-}
-
 // PromptConfig holds the configuration for LLM calls
 type PromptConfig struct {
+	// Backend is the name of the backend, see NewBackend
+	Backend     string
 	BaseURL     string
+	APIKey      string
 	Model       string
 	Temperature float64
 	MaxTokens   int
 	Timeout     time.Duration
+	// FakeFile is the response file of the fake backend
+	FakeFile string
+	// CacheDir is the cassette directory of the prompt cache
+	CacheDir  string
+	CacheMode CacheMode
 }
 
 // DefaultConfig returns a default LLM configuration
 func DefaultConfig() PromptConfig {
 	return PromptConfig{
-		BaseURL:     "http://localhost:18080/v1",
+		Backend:     OpenAIBackendName,
+		BaseURL:     DefaultBaseURL(OpenAIBackendName),
 		Model:       "bitnet",
 		Temperature: 0.7,
 		MaxTokens:   1000,
 		Timeout:     120 * time.Second,
+		CacheMode:   CacheOff,
 	}
 }
 
-// PromptClientImpl sends a prompt to the LLM server and receives a response
-func PromptClientImpl(client PromptClient, config PromptConfig, apiKey string, prompt string) (string, error) {
-
-	// Validate inputs
-	if apiKey == "" {
-		return "", fmt.Errorf("empty API key")
-	}
-
-	if prompt == "" {
-		return "", fmt.Errorf("empty prompt")
-	}
-
-	messages := []Message{
-		{Role: "user", Content: prompt},
-	}
-
-	reqBody := ChatRequest{
-		Model:       config.Model,
-		Messages:    messages,
-		Temperature: config.Temperature,
-		MaxTokens:   config.MaxTokens,
-	}
-
-	jsonBody, err := json.Marshal(reqBody)
-	if err != nil {
-		return "", fmt.Errorf("failed to marshal request: %w", err)
-	}
-
-	// Log the request details
-	loggers.Printf(loggers.Debug, "LLM Request:")
-	loggers.Printf(loggers.Debug, "URL: %s", config.BaseURL+"/chat/completions")
-	loggers.Printf(loggers.Debug, "Model: %s", config.Model)
-	loggers.Printf(loggers.Debug, "Temperature: %f", config.Temperature)
-	loggers.Printf(loggers.Debug, "MaxTokens: %d", config.MaxTokens)
-	loggers.Printf(loggers.Debug, "Messages: %+v", messages)
-
-	req, err := http.NewRequest("POST", config.BaseURL+"/chat/completions", bytes.NewBuffer(jsonBody))
-	if err != nil {
-		return "", fmt.Errorf("failed to create request: %w", err)
-	}
-
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Authorization", "Bearer "+apiKey)
-
-	resp, err := client.Do(req)
-	if err != nil {
-		return "", fmt.Errorf("failed to send request: %w", err)
-	}
-	defer resp.Body.Close()
-
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return "", fmt.Errorf("failed to read response: %w", err)
-	}
-
-	// Log the response details
-	loggers.Printf(loggers.Debug, "LLM Response:")
-	loggers.Printf(loggers.Debug, "Status: %s", resp.Status)
-	loggers.Printf(loggers.Debug, "Body: %s", string(body))
-
-	if resp.StatusCode != http.StatusOK {
-		return "", fmt.Errorf("API error: %s", body)
-	}
-
-	var chatResp ChatResponse
-	if err := json.Unmarshal(body, &chatResp); err != nil {
-		return "", fmt.Errorf("failed to unmarshal response: %w", err)
-	}
+// RequiresApiKey returns true if prompts cannot be sent without an API key
+func (c PromptConfig) RequiresApiKey() bool {
+	return c.Backend == OpenAIBackendName && c.APIKey == "" && c.CacheMode != CacheReplayOrFail
+}
 
-	if len(chatResp.Choices) == 0 {
-		return "", fmt.Errorf("no response from API")
+// NewCompletionRequest creates a request for prompt using the model
+// parameters of the configuration
+func (c PromptConfig) NewCompletionRequest(prompt string) *CompletionRequest {
+	return &CompletionRequest{
+		Prompt:      prompt,
+		Model:       c.Model,
+		Temperature: c.Temperature,
+		MaxTokens:   c.MaxTokens,
 	}
+}
 
-	// Log the final response content
-	loggers.Printf(loggers.Debug, "Response Content: %s", chatResp.Choices[0].Message.Content)
-
-	return chatResp.Choices[0].Message.Content, nil
+// PromptClientImpl sends a prompt to the LLM server and receives a response
+//
+// Deprecated: Use NewOpenAIBackend, or NewBackend to choose the backend from
+// the configuration.
+func PromptClientImpl(client PromptClient, config PromptConfig, apiKey string, prompt string) (string, error) {
+	backend := NewOpenAIBackend(client, config.BaseURL, apiKey)
+	return backend.Complete(context.Background(), config.NewCompletionRequest(prompt))
 }
@@ -0,0 +1,37 @@
+package prompts
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPromptClientImpl(t *testing.T) {
+	var got ChatRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer test-key", r.Header.Get("Authorization"))
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"choices": []interface{}{
+				map[string]interface{}{
+					"message": map[string]interface{}{"role": "assistant", "content": "yes"},
+				},
+			},
+		})
+	}))
+	defer server.Close()
+
+	config := DefaultConfig()
+	config.BaseURL = server.URL
+	reply, err := PromptClientImpl(server.Client(), config, "test-key", "Are you there?")
+	assert.NoError(t, err)
+	assert.Equal(t, "yes", reply)
+	assert.Equal(t, "bitnet", got.Model)
+	assert.Equal(t, []Message{{Role: "user", Content: "Are you there?"}}, got.Messages)
+
+	_, err = PromptClientImpl(server.Client(), config, "", "Are you there?")
+	assert.ErrorIs(t, err, PromptEmptyApiKeyError)
+}
@@ -0,0 +1,106 @@
+package runtime_errors
+
+import (
+	"context"
+	"errors"
+	"regexp"
+)
+
+const (
+	// DefaultErrorCode is the code of errors raised without a code, and of
+	// errors of primitives that do not set one
+	DefaultErrorCode = "error"
+	// TimeoutErrorCode is the code of errors caused by an exceeded time limit
+	TimeoutErrorCode = "timeout"
+	// CancelledErrorCode is the code of errors caused by a cancelled task
+	CancelledErrorCode = "cancelled"
+)
+
+// errorCodePattern matches error codes such as "timeout" or "invalid-input"
+var errorCodePattern = regexp.MustCompile(`^[a-z][a-z0-9]*(-[a-z0-9]+)*$`)
+
+// IsErrorCode returns true if s has the form of an error code: lowercase
+// words of letters and digits separated by hyphens
+func IsErrorCode(s string) bool {
+	return errorCodePattern.MatchString(s)
+}
+
+// ErrorValue is an error as seen by a GND program. It carries a code to
+// react on, the message, optional data and the error that caused it, and it
+// is the value that try and wait report for a failed routine.
+type ErrorValue struct {
+	Code    string
+	Message string
+	// Data is the payload given to throw, or nil
+	Data interface{}
+	// Position is "unit:line:column" of the instruction that raised the
+	// error, or empty if it is not known
+	Position string
+	// Cause is the wrapped error, or nil
+	Cause error
+}
+
+// NewErrorValue creates an ErrorValue. An empty code is DefaultErrorCode.
+func NewErrorValue(code, message string, data interface{}, cause error) *ErrorValue {
+	if code == "" {
+		code = DefaultErrorCode
+	}
+	return &ErrorValue{Code: code, Message: message, Data: data, Cause: cause}
+}
+
+// Error returns the message
+func (e *ErrorValue) Error() string {
+	return e.Message
+}
+
+// Unwrap returns the cause
+func (e *ErrorValue) Unwrap() error {
+	return e.Cause
+}
+
+// CauseValue returns the closest ErrorValue in the cause chain, or nil if
+// the error was not caused by one
+func (e *ErrorValue) CauseValue() *ErrorValue {
+	var cause *ErrorValue
+	if e.Cause != nil && errors.As(e.Cause, &cause) {
+		return cause
+	}
+	return nil
+}
+
+// NewContextError converts the error of a done context into an ErrorValue
+// with TimeoutErrorCode if the deadline was exceeded, or CancelledErrorCode
+// if the context was cancelled
+func NewContextError(err error) *ErrorValue {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return NewErrorValue(TimeoutErrorCode, "timed out", nil, err)
+	}
+	return NewErrorValue(CancelledErrorCode, "cancelled", nil, err)
+}
+
+// ToErrorValue converts an error caught from a routine into an ErrorValue.
+// An ErrorValue raised inside the routine is returned as it is, with the
+// position of the instruction that raised it. Any other error becomes an
+// ErrorValue with DefaultErrorCode and the message of the original error,
+// without the positions of the instructions it propagated through.
+func ToErrorValue(err error) *ErrorValue {
+	position := ""
+	if gndErr, ok := GetGndError(err); ok {
+		// Frames are ordered innermost first, also across nested routines
+		if len(gndErr.Frames) > 0 {
+			position = gndErr.Frames[0].Position()
+		}
+		err = gndErr.Cause()
+	}
+
+	var value *ErrorValue
+	if !errors.As(err, &value) {
+		value = NewErrorValue(DefaultErrorCode, err.Error(), nil, err)
+	}
+	if value.Position == "" && position != "" {
+		copied := *value
+		copied.Position = position
+		value = &copied
+	}
+	return value
+}
@@ -0,0 +1,59 @@
+package runtime_errors
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsErrorCode(t *testing.T) {
+	for _, code := range []string{"timeout", "invalid-input", "http-404", "e2e"} {
+		assert.True(t, IsErrorCode(code), code)
+	}
+	for _, s := range []string{"", "Timeout", "bad input", "-x", "x-", "a--b", "4xx", "bad:"} {
+		assert.False(t, IsErrorCode(s), s)
+	}
+}
+
+func TestNewErrorValue(t *testing.T) {
+	value := NewErrorValue("", "failed", nil, nil)
+	assert.Equal(t, DefaultErrorCode, value.Code)
+	assert.Equal(t, "failed", value.Error())
+	assert.Nil(t, value.CauseValue())
+
+	cause := NewErrorValue("timeout", "slow", nil, nil)
+	wrapped := NewErrorValue("fetch-failed", "fetch failed", "url", fmt.Errorf("context: %w", cause))
+	assert.Same(t, cause, wrapped.CauseValue())
+	assert.ErrorIs(t, wrapped, cause)
+}
+
+func TestToErrorValue(t *testing.T) {
+	plain := ToErrorValue(errBoom)
+	assert.Equal(t, DefaultErrorCode, plain.Code)
+	assert.Equal(t, "boom", plain.Message)
+	assert.Equal(t, "", plain.Position)
+	assert.ErrorIs(t, plain, errBoom)
+	assert.Nil(t, plain.CauseValue())
+
+	// A runtime error loses the positions added while it propagated
+	inner := WithFrame(errBoom, Frame{Unit: "inner.gnd", Line: 2, Column: 1, Opcode: "/gnd/div"})
+	outer := WithFrame(fmt.Errorf("exec: routine execution failed: %w", inner), Frame{Unit: "main.gnd", Line: 5, Column: 1, Opcode: "/gnd/exec"})
+	value := ToErrorValue(outer)
+	assert.Equal(t, "boom", value.Message)
+	assert.Equal(t, "inner.gnd:2:1", value.Position)
+
+	// A thrown error value keeps its code and data
+	thrown := NewErrorValue("invalid-input", "invalid-input name", "name", nil)
+	value = ToErrorValue(WithFrame(thrown, Frame{Unit: "a.gnd", Line: 3, Column: 1, Opcode: "/gnd/throw"}))
+	assert.Equal(t, "invalid-input", value.Code)
+	assert.Equal(t, "name", value.Data)
+	assert.Equal(t, "a.gnd:3:1", value.Position)
+	assert.Equal(t, "", thrown.Position, "the thrown value is not modified")
+
+	// A value that already has a position keeps it when thrown again
+	again := ToErrorValue(WithFrame(value, Frame{Unit: "b.gnd", Line: 9, Column: 1, Opcode: "/gnd/throw"}))
+	assert.Equal(t, "a.gnd:3:1", again.Position)
+	assert.True(t, errors.Is(again, value))
+}
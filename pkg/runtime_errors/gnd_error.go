@@ -0,0 +1,107 @@
+package runtime_errors
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Frame is a single entry of the call stack of a GndError
+type Frame struct {
+	Unit   string // Path of the unit or source name of the instruction block
+	Line   int    // 1-based line number, or 0 if unknown
+	Column int    // 1-based column number, or 0 if unknown
+	Opcode string // Resolved opcode of the failing instruction
+	Index  int    // Index of the instruction in its block, used when Line is unknown
+}
+
+// String returns the frame formatted as "unit:line:column: opcode"
+func (f Frame) String() string {
+	return f.Position() + ": " + f.Opcode
+}
+
+// Position returns "unit:line:column" or "unit#index" when the line is not known
+func (f Frame) Position() string {
+	switch {
+	case f.Line > 0 && f.Column > 0:
+		return fmt.Sprintf("%s:%d:%d", f.Unit, f.Line, f.Column)
+	case f.Line > 0:
+		return fmt.Sprintf("%s:%d", f.Unit, f.Line)
+	default:
+		return fmt.Sprintf("%s#%d", f.Unit, f.Index)
+	}
+}
+
+// GndError is a runtime error together with the call stack of the GND
+// instructions it propagated through. Frames are ordered innermost first.
+type GndError struct {
+	Err    error
+	Frames []Frame
+}
+
+// Error returns the position of the innermost frame and the error message
+func (e *GndError) Error() string {
+	if len(e.Frames) == 0 {
+		return e.Err.Error()
+	}
+	return e.Frames[0].Position() + ": " + e.Err.Error()
+}
+
+// Unwrap returns the underlying error
+func (e *GndError) Unwrap() error {
+	return e.Err
+}
+
+// Cause returns the error that started the call stack, without the context
+// added by the instructions it propagated through
+func (e *GndError) Cause() error {
+	cause := e.Err
+	var inner *GndError
+	for errors.As(cause, &inner) {
+		cause = inner.Err
+	}
+	return cause
+}
+
+// Traceback renders the call stack, most recent call last, followed by the
+// message of the original error
+func (e *GndError) Traceback() string {
+	var b strings.Builder
+	b.WriteString("Traceback (most recent call last):\n")
+	for idx := len(e.Frames) - 1; idx >= 0; idx-- {
+		b.WriteString("  ")
+		b.WriteString(e.Frames[idx].String())
+		b.WriteString("\n")
+	}
+	b.WriteString("Error: ")
+	b.WriteString(e.Cause().Error())
+	return b.String()
+}
+
+// WithFrame records that err propagated through the instruction described by
+// frame. If err already carries a GndError, the frame is added to its stack.
+func WithFrame(err error, frame Frame) *GndError {
+	var inner *GndError
+	if !errors.As(err, &inner) {
+		return &GndError{Err: err, Frames: []Frame{frame}}
+	}
+
+	frames := make([]Frame, 0, len(inner.Frames)+1)
+	frames = append(frames, inner.Frames...)
+	frames = append(frames, frame)
+
+	if inner == err {
+		return &GndError{Err: inner.Err, Frames: frames}
+	}
+	// err adds context around the GndError, keep it as the message
+	return &GndError{Err: err, Frames: frames}
+}
+
+// GetGndError extracts the GndError from an error chain if there is one
+func GetGndError(err error) (*GndError, bool) {
+	var gndErr *GndError
+	if errors.As(err, &gndErr) {
+		return gndErr, true
+	}
+	return nil, false
+}
@@ -0,0 +1,57 @@
+package runtime_errors
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var errBoom = errors.New("boom")
+
+func TestFramePosition(t *testing.T) {
+	assert.Equal(t, "a.gnd:3:5", Frame{Unit: "a.gnd", Line: 3, Column: 5}.Position())
+	assert.Equal(t, "a.gnd:3", Frame{Unit: "a.gnd", Line: 3}.Position())
+	assert.Equal(t, "a.gnd#2", Frame{Unit: "a.gnd", Index: 2}.Position())
+	assert.Equal(t, "a.gnd:3:5: /gnd/throw", Frame{Unit: "a.gnd", Line: 3, Column: 5, Opcode: "/gnd/throw"}.String())
+}
+
+func TestWithFrame(t *testing.T) {
+	inner := WithFrame(errBoom, Frame{Unit: "inner.gnd", Line: 2, Column: 1, Opcode: "/gnd/throw"})
+	assert.Equal(t, "inner.gnd:2:1: boom", inner.Error())
+
+	outer := WithFrame(inner, Frame{Unit: "main.gnd", Line: 4, Column: 1, Opcode: "inner"})
+	assert.Len(t, outer.Frames, 2)
+	assert.Equal(t, "inner.gnd:2:1: boom", outer.Error())
+	assert.True(t, errors.Is(outer, errBoom))
+	assert.Equal(t, errBoom, outer.Cause())
+
+	assert.Equal(t,
+		"Traceback (most recent call last):\n"+
+			"  main.gnd:4:1: inner\n"+
+			"  inner.gnd:2:1: /gnd/throw\n"+
+			"Error: boom",
+		outer.Traceback(),
+	)
+}
+
+func TestWithFrameKeepsContext(t *testing.T) {
+	inner := WithFrame(errBoom, Frame{Unit: "inner.gnd", Line: 2, Opcode: "/gnd/throw"})
+	wrapped := fmt.Errorf("exec failed: %w", inner)
+
+	outer := WithFrame(wrapped, Frame{Unit: "main.gnd", Line: 1, Opcode: "/gnd/exec"})
+	assert.Len(t, outer.Frames, 2)
+	assert.Equal(t, "inner.gnd:2: exec failed: inner.gnd:2: boom", outer.Error())
+	assert.Equal(t, errBoom, outer.Cause())
+	assert.True(t, errors.Is(outer, errBoom))
+}
+
+func TestGetGndError(t *testing.T) {
+	_, ok := GetGndError(errBoom)
+	assert.False(t, ok)
+
+	gndErr, ok := GetGndError(fmt.Errorf("context: %w", WithFrame(errBoom, Frame{Unit: "a.gnd"})))
+	assert.True(t, ok)
+	assert.Equal(t, "a.gnd", gndErr.Frames[0].Unit)
+}
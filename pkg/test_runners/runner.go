@@ -0,0 +1,130 @@
+package test_runners
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/hyperifyio/gnd/pkg/interpreters"
+	"github.com/hyperifyio/gnd/pkg/loggers"
+	"github.com/hyperifyio/gnd/pkg/primitive_services"
+	"github.com/hyperifyio/gnd/pkg/primitives"
+	"github.com/hyperifyio/gnd/pkg/prompts"
+	"github.com/hyperifyio/gnd/pkg/units"
+)
+
+var RunnerApiKeyNotSetError = errors.New("OPENAI_API_KEY environment variable not set")
+var RunnerInconclusiveVerdictError = errors.New("model reply did not start with PASS or FAIL")
+
+// TestResult is the outcome of a single test case
+type TestResult struct {
+	Case     *TestCase
+	Passed   bool
+	Skipped  bool
+	Message  string
+	Duration time.Duration
+}
+
+// Runner executes test cases
+type Runner struct {
+	Backend prompts.Backend
+	Config  prompts.PromptConfig
+	// SkipLlm skips .test.llm cases instead of sending them to the model
+	SkipLlm bool
+}
+
+// NewRunner creates a new test runner
+func NewRunner(backend prompts.Backend, config prompts.PromptConfig) *Runner {
+	return &Runner{
+		Backend: backend,
+		Config:  config,
+	}
+}
+
+// Run executes a single test case and reports the outcome
+func (r *Runner) Run(tc *TestCase) *TestResult {
+	start := time.Now()
+	var result *TestResult
+	switch tc.Kind {
+	case GndTest:
+		result = r.RunGndTest(tc)
+	case LlmTest:
+		result = r.RunLlmTest(tc)
+	default:
+		result = &TestResult{Case: tc, Message: fmt.Sprintf("unsupported test kind: %v", tc.Kind)}
+	}
+	result.Duration = time.Since(start)
+	return result
+}
+
+// RunGndTest executes a .test.gnd pipeline, including all of its fragments.
+// The test fails if the pipeline throws, fails to parse, or exits with a
+// non-zero code.
+func (r *Runner) RunGndTest(tc *TestCase) *TestResult {
+	instructions, err := units.LoadUnitFile(tc.Path)
+	if err != nil {
+		return &TestResult{Case: tc, Message: fmt.Sprintf("failed to load test: %v", err)}
+	}
+
+	args := []interface{}{}
+	interpreter := interpreters.NewInterpreter(filepath.Dir(tc.Path), primitive_services.GetDefaultOpcodeMap())
+	interpreter.SetSlot("_", args)
+
+	loggers.Printf(loggers.Debug, "[%s]: RunGndTest: executing %d instructions", tc.Path, len(instructions))
+	if _, err = interpreter.ExecuteInstructionBlock(tc.Path, args, instructions); err != nil {
+		if exitErr, ok := primitives.GetExitResult(err); ok {
+			if exitErr.Code == 0 {
+				return &TestResult{Case: tc, Passed: true}
+			}
+			return &TestResult{Case: tc, Message: fmt.Sprintf("exit with code %d", exitErr.Code)}
+		}
+		return &TestResult{Case: tc, Message: strings.TrimSpace(err.Error())}
+	}
+	return &TestResult{Case: tc, Passed: true}
+}
+
+// RunLlmTest asks the language model to judge the assertions of a .test.llm
+// file against the header and implementation of the unit under test.
+func (r *Runner) RunLlmTest(tc *TestCase) *TestResult {
+	if r.SkipLlm {
+		return &TestResult{Case: tc, Skipped: true, Message: "model-judged tests disabled"}
+	}
+	if r.Config.RequiresApiKey() {
+		return &TestResult{Case: tc, Message: RunnerApiKeyNotSetError.Error()}
+	}
+
+	assertions, err := os.ReadFile(tc.Path)
+	if err != nil {
+		return &TestResult{Case: tc, Message: fmt.Sprintf("failed to read test: %v", err)}
+	}
+
+	dir := filepath.Dir(tc.Path)
+	name := tc.UnitName()
+	header := readOptionalFile(filepath.Join(dir, name+".llm"))
+	implementation := readOptionalFile(filepath.Join(dir, name+".gnd"))
+
+	prompt := BuildJudgePrompt(name, header, implementation, string(assertions))
+	reply, err := r.Backend.Complete(context.Background(), r.Config.NewCompletionRequest(prompt))
+	if err != nil {
+		return &TestResult{Case: tc, Message: fmt.Sprintf("prompt failed: %v", err)}
+	}
+
+	passed, reason, err := ParseVerdict(reply)
+	if err != nil {
+		return &TestResult{Case: tc, Message: fmt.Sprintf("%v: %s", err, strings.TrimSpace(reply))}
+	}
+	return &TestResult{Case: tc, Passed: passed, Message: reason}
+}
+
+// readOptionalFile returns the content of a file, or an empty string if it cannot be read
+func readOptionalFile(path string) string {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return ""
+	}
+	return string(content)
+}
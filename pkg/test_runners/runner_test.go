@@ -0,0 +1,123 @@
+package test_runners
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hyperifyio/gnd/pkg/prompts"
+	"github.com/stretchr/testify/assert"
+)
+
+func writeTest(t *testing.T, dir, name, content string) *TestCase {
+	path := filepath.Join(dir, name)
+	assert.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	tests, err := DiscoverTests(path)
+	assert.NoError(t, err)
+	assert.Len(t, tests, 1)
+	return tests[0]
+}
+
+func TestRunGndTest(t *testing.T) {
+	tests := []struct {
+		name       string
+		content    string
+		wantPassed bool
+		wantMsg    string
+	}{
+		{
+			name:       "completes",
+			content:    "$x let \"hello\"\nuppercase $x\n",
+			wantPassed: true,
+		},
+		{
+			name:       "exit zero",
+			content:    "exit 0\nthrow unreachable\n",
+			wantPassed: true,
+		},
+		{
+			name:    "exit non-zero",
+			content: "exit 3\n",
+			wantMsg: "exit with code 3",
+		},
+		{
+			name:    "throw",
+			content: "throw \"expected 3\"\n",
+			wantMsg: "expected 3",
+		},
+		{
+			name:    "parse error",
+			content: "$x let \"unterminated\n",
+			wantMsg: "failed to load test",
+		},
+	}
+
+	runner := NewRunner(nil, prompts.DefaultConfig())
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tc := writeTest(t, t.TempDir(), "unit.test.gnd", tt.content)
+			result := runner.Run(tc)
+			assert.Equal(t, tt.wantPassed, result.Passed, result.Message)
+			assert.Contains(t, result.Message, tt.wantMsg)
+		})
+	}
+}
+
+func TestRunLlmTest(t *testing.T) {
+	var prompt string
+	reply := "PASS\nAll assertions hold."
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req prompts.ChatRequest
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		prompt = req.Messages[0].Content
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"choices": []interface{}{
+				map[string]interface{}{
+					"message": map[string]interface{}{"role": "assistant", "content": reply},
+				},
+			},
+		})
+	}))
+	defer server.Close()
+
+	dir := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "normalize.llm"), []byte("Normalizes replies."), 0644))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "normalize.gnd"), []byte("trim\nlowercase\n"), 0644))
+	tc := writeTest(t, dir, "normalize.test.llm", "The result is lowercase.")
+
+	config := prompts.DefaultConfig()
+	config.BaseURL = server.URL
+	config.APIKey = "test-key"
+	runner := NewRunner(prompts.NewOpenAIBackend(server.Client(), config.BaseURL, config.APIKey), config)
+
+	result := runner.Run(tc)
+	assert.True(t, result.Passed)
+	assert.Equal(t, "All assertions hold.", result.Message)
+	assert.Contains(t, prompt, "Normalizes replies.")
+	assert.Contains(t, prompt, "trim\nlowercase")
+	assert.Contains(t, prompt, "The result is lowercase.")
+
+	reply = "FAIL: uppercase letters remain"
+	result = runner.Run(tc)
+	assert.False(t, result.Passed)
+	assert.Equal(t, "uppercase letters remain", result.Message)
+
+	reply = "Maybe?"
+	result = runner.Run(tc)
+	assert.False(t, result.Passed)
+	assert.Contains(t, result.Message, RunnerInconclusiveVerdictError.Error())
+
+	runner.SkipLlm = true
+	result = runner.Run(tc)
+	assert.True(t, result.Skipped)
+}
+
+func TestRunLlmTestWithoutApiKey(t *testing.T) {
+	tc := writeTest(t, t.TempDir(), "unit.test.llm", "Anything.")
+	result := NewRunner(nil, prompts.DefaultConfig()).Run(tc)
+	assert.False(t, result.Passed)
+	assert.Equal(t, RunnerApiKeyNotSetError.Error(), result.Message)
+}
@@ -0,0 +1,98 @@
+package test_runners
+
+import (
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/hyperifyio/gnd/pkg/units"
+)
+
+const (
+	GndTestExt = ".test.gnd"
+	LlmTestExt = ".test.llm"
+)
+
+// TestKind identifies how a test case is evaluated
+type TestKind int
+
+const (
+	// GndTest is an executable .test.gnd pipeline
+	GndTest TestKind = iota
+	// LlmTest is a .test.llm file judged by the language model
+	LlmTest
+)
+
+// String returns the text label for a TestKind
+func (k TestKind) String() string {
+	switch k {
+	case GndTest:
+		return "gnd"
+	case LlmTest:
+		return "llm"
+	default:
+		return fmt.Sprintf("unknown(%d)", k)
+	}
+}
+
+// TestCase is a single test file found by DiscoverTests
+type TestCase struct {
+	Path string
+	Kind TestKind
+}
+
+// UnitName returns the base name of the unit under test, e.g. "sum" for sum.test.gnd
+func (tc *TestCase) UnitName() string {
+	base := filepath.Base(tc.Path)
+	switch tc.Kind {
+	case GndTest:
+		return strings.TrimSuffix(base, GndTestExt)
+	default:
+		return strings.TrimSuffix(base, LlmTestExt)
+	}
+}
+
+// String returns a string representation of the TestCase
+func (tc *TestCase) String() string {
+	return "TestCase{" + tc.Kind.String() + ", " + tc.Path + "}"
+}
+
+// DiscoverTests recursively finds .test.gnd and .test.llm files under root.
+// If root is a test file itself, it is returned as the only test case.
+// Fragments of the same .test.gnd unit are reported once, since the whole
+// unit is loaded when the test runs.
+func DiscoverTests(root string) ([]*TestCase, error) {
+	var tests []*TestCase
+	seen := make(map[string]bool)
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		switch {
+		case strings.HasSuffix(path, GndTestExt):
+			if fragment, ok := units.ParseFragmentName(filepath.Base(path), GndTestExt); ok {
+				key := filepath.Join(filepath.Dir(path), fragment.Base)
+				if seen[key] {
+					return nil
+				}
+				seen[key] = true
+			}
+			tests = append(tests, &TestCase{Path: path, Kind: GndTest})
+		case strings.HasSuffix(path, LlmTestExt):
+			tests = append(tests, &TestCase{Path: path, Kind: LlmTest})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("[%s]: DiscoverTests: %w", root, err)
+	}
+	sort.Slice(tests, func(a, b int) bool {
+		return tests[a].Path < tests[b].Path
+	})
+	return tests, nil
+}
@@ -0,0 +1,60 @@
+package test_runners
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiscoverTests(t *testing.T) {
+	dir := t.TempDir()
+	assert.NoError(t, os.MkdirAll(filepath.Join(dir, "nested", "deeper"), 0755))
+	for _, name := range []string{
+		"sum.gnd",
+		"sum.test.gnd",
+		"sum.test.llm",
+		"sum.test.gnd.llm",
+		filepath.Join("nested", "greet.test.gnd"),
+		filepath.Join("nested", "deeper", "greet.test.llm"),
+	} {
+		assert.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte("x"), 0644))
+	}
+
+	tests, err := DiscoverTests(dir)
+	assert.NoError(t, err)
+	assert.Equal(t, []*TestCase{
+		{Path: filepath.Join(dir, "nested", "deeper", "greet.test.llm"), Kind: LlmTest},
+		{Path: filepath.Join(dir, "nested", "greet.test.gnd"), Kind: GndTest},
+		{Path: filepath.Join(dir, "sum.test.gnd"), Kind: GndTest},
+		{Path: filepath.Join(dir, "sum.test.llm"), Kind: LlmTest},
+	}, tests)
+
+	// A single test file may be given directly
+	tests, err = DiscoverTests(filepath.Join(dir, "sum.test.gnd"))
+	assert.NoError(t, err)
+	assert.Equal(t, []*TestCase{{Path: filepath.Join(dir, "sum.test.gnd"), Kind: GndTest}}, tests)
+
+	_, err = DiscoverTests(filepath.Join(dir, "missing"))
+	assert.Error(t, err)
+}
+
+func TestTestCaseUnitName(t *testing.T) {
+	assert.Equal(t, "sum", (&TestCase{Path: "/a/sum.test.gnd", Kind: GndTest}).UnitName())
+	assert.Equal(t, "greet", (&TestCase{Path: "b/greet.test.llm", Kind: LlmTest}).UnitName())
+}
+
+func TestDiscoverTestsReportsFragmentedUnitOnce(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"010-sum.test.gnd", "sum.test.gnd", "other.test.gnd"} {
+		assert.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte("x"), 0644))
+	}
+
+	tests, err := DiscoverTests(dir)
+	assert.NoError(t, err)
+	assert.Equal(t, []*TestCase{
+		{Path: filepath.Join(dir, "010-sum.test.gnd"), Kind: GndTest},
+		{Path: filepath.Join(dir, "other.test.gnd"), Kind: GndTest},
+	}, tests)
+}
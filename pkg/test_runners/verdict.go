@@ -0,0 +1,52 @@
+package test_runners
+
+import (
+	"strings"
+)
+
+// BuildJudgePrompt constructs the prompt used to evaluate a .test.llm file
+func BuildJudgePrompt(unitName, header, implementation, assertions string) string {
+	var b strings.Builder
+	b.WriteString("You are the Gendo test judge. Decide whether the unit \"" + unitName + "\" satisfies every assertion below.\n")
+
+	if header != "" {
+		b.WriteString("\nUnit header:\n---\n")
+		b.WriteString(strings.TrimSpace(header))
+		b.WriteString("\n---\n")
+	}
+
+	if implementation != "" {
+		b.WriteString("\nUnit implementation (.gnd):\n---\n")
+		b.WriteString(strings.TrimSpace(implementation))
+		b.WriteString("\n---\n")
+	}
+
+	b.WriteString("\nAssertions:\n---\n")
+	b.WriteString(strings.TrimSpace(assertions))
+	b.WriteString("\n---\n")
+
+	b.WriteString("\nReply with PASS or FAIL on the first line, followed by a short reason.\n")
+	return b.String()
+}
+
+// ParseVerdict reads the PASS/FAIL verdict from the first word of a model reply.
+// The rest of the reply is returned as the reason.
+func ParseVerdict(reply string) (bool, string, error) {
+	text := strings.TrimSpace(reply)
+	word := text
+	if idx := strings.IndexFunc(text, func(r rune) bool {
+		return !(r >= 'A' && r <= 'Z' || r >= 'a' && r <= 'z')
+	}); idx >= 0 {
+		word = text[:idx]
+	}
+	reason := strings.TrimSpace(strings.TrimLeft(text[len(word):], ":.-*"))
+
+	switch strings.ToUpper(word) {
+	case "PASS", "PASSED":
+		return true, reason, nil
+	case "FAIL", "FAILED":
+		return false, reason, nil
+	default:
+		return false, "", RunnerInconclusiveVerdictError
+	}
+}
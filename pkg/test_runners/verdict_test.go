@@ -0,0 +1,71 @@
+package test_runners
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseVerdict(t *testing.T) {
+	tests := []struct {
+		name       string
+		reply      string
+		wantPassed bool
+		wantReason string
+		wantErr    bool
+	}{
+		{
+			name:       "pass",
+			reply:      "PASS",
+			wantPassed: true,
+		},
+		{
+			name:       "pass with reason",
+			reply:      "  Pass: output is trimmed\n",
+			wantPassed: true,
+			wantReason: "output is trimmed",
+		},
+		{
+			name:       "fail with reason on next line",
+			reply:      "FAIL\nThe unit does not lowercase.",
+			wantReason: "The unit does not lowercase.",
+		},
+		{
+			name:       "markdown emphasis",
+			reply:      "**FAILED** - missing case",
+			wantErr:    true,
+			wantReason: "",
+		},
+		{
+			name:    "inconclusive",
+			reply:   "I think it works.",
+			wantErr: true,
+		},
+		{
+			name:    "empty",
+			reply:   "",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			passed, reason, err := ParseVerdict(tt.reply)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, RunnerInconclusiveVerdictError)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantPassed, passed)
+			assert.Equal(t, tt.wantReason, reason)
+		})
+	}
+}
+
+func TestBuildJudgePrompt(t *testing.T) {
+	prompt := BuildJudgePrompt("sum", "Sums numbers.", "", "Returns 3 for 1 2.")
+	assert.Contains(t, prompt, "\"sum\"")
+	assert.Contains(t, prompt, "Sums numbers.")
+	assert.Contains(t, prompt, "Returns 3 for 1 2.")
+	assert.NotContains(t, prompt, "Unit implementation")
+}
@@ -0,0 +1,61 @@
+package units
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/hyperifyio/gnd/pkg/parsers"
+)
+
+// GncPath returns the path of the compiled .gnc file for the unit that the
+// file at filePath belongs to, e.g. dir/sum.gnc for dir/010-sum.gnd
+func GncPath(filePath string) string {
+	dir := filepath.Dir(filePath)
+	if fragment, ok := ParseFragmentName(filepath.Base(filePath), GndExt); ok {
+		return filepath.Join(dir, fragment.Base+GncExt)
+	}
+	return filepath.Join(dir, filepath.Base(filePath)+GncExt)
+}
+
+// ResolveOpcodes returns a copy of the instructions with opcode aliases
+// replaced by the names they map to in opcodeMap
+func ResolveOpcodes(instructions []*parsers.Instruction, opcodeMap map[string]string) []*parsers.Instruction {
+	resolved := make([]*parsers.Instruction, 0, len(instructions))
+	for _, op := range instructions {
+		if op == nil {
+			continue
+		}
+		opcode := op.Opcode
+		if mapped, ok := opcodeMap[opcode]; ok {
+			opcode = mapped
+		}
+		resolved = append(resolved, parsers.NewInstruction(opcode, op.Destination, op.Arguments))
+	}
+	return resolved
+}
+
+// CompileInstructions resolves opcode aliases and serializes the instructions
+// in the .gnc format
+func CompileInstructions(instructions []*parsers.Instruction, opcodeMap map[string]string) (string, error) {
+	return parsers.FormatGnc(ResolveOpcodes(instructions, opcodeMap))
+}
+
+// CompileUnitFile compiles the .gnd sources of the unit that the file at
+// filePath belongs to and writes the result to outPath
+func CompileUnitFile(filePath, outPath string, opcodeMap map[string]string) error {
+	instructions, err := LoadSourcesFile(filePath)
+	if err != nil {
+		return err
+	}
+
+	content, err := CompileInstructions(instructions, opcodeMap)
+	if err != nil {
+		return fmt.Errorf("[%s]: CompileUnit: %w", filePath, err)
+	}
+
+	if err := os.WriteFile(outPath, []byte(content), 0644); err != nil {
+		return fmt.Errorf("[%s]: CompileUnit: failed to write %s: %w", filePath, outPath, err)
+	}
+	return nil
+}
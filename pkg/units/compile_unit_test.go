@@ -0,0 +1,40 @@
+package units
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hyperifyio/gnd/pkg/parsers"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGncPath(t *testing.T) {
+	assert.Equal(t, filepath.Join("dir", "sum.gnc"), GncPath(filepath.Join("dir", "sum.gnd")))
+	assert.Equal(t, filepath.Join("dir", "sum.gnc"), GncPath(filepath.Join("dir", "010-sum.gnd")))
+	assert.Equal(t, filepath.Join("dir", "sum.gnc"), GncPath(filepath.Join("dir", "sum-2.gnd")))
+}
+
+func TestResolveOpcodes(t *testing.T) {
+	instructions := []*parsers.Instruction{
+		parsers.NewInstruction("let", parsers.NewPropertyRef("x"), []interface{}{"1"}),
+		parsers.NewInstruction("custom", parsers.NewPropertyRef("y"), nil),
+	}
+	resolved := ResolveOpcodes(instructions, map[string]string{"let": "/gnd/let"})
+	assert.Equal(t, "/gnd/let", resolved[0].Opcode)
+	assert.Equal(t, "custom", resolved[1].Opcode)
+	assert.Equal(t, "let", instructions[0].Opcode, "input must not be modified")
+}
+
+func TestCompileUnitFile(t *testing.T) {
+	dir := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "010-sum.gnd"), []byte("$a let 1\n"), 0644))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "sum.gnd"), []byte("$b let \"x y\"\n"), 0644))
+
+	outPath := GncPath(filepath.Join(dir, "sum.gnd"))
+	assert.NoError(t, CompileUnitFile(filepath.Join(dir, "sum.gnd"), outPath, map[string]string{"let": "/gnd/let"}))
+
+	content, err := os.ReadFile(outPath)
+	assert.NoError(t, err)
+	assert.Equal(t, "gnc 1\n$a /gnd/let int64:1\n$b /gnd/let \"x y\"\n", string(content))
+}
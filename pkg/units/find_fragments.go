@@ -0,0 +1,43 @@
+package units
+
+import (
+	"fmt"
+	"io/fs"
+	"sort"
+)
+
+// FindFragments returns all fragments of the unit named base (e.g. "sum") in
+// dir of fsys, in concatenation order: prefix-numbered fragments in ascending
+// numeric order, then unnumbered fragments, then suffix-numbered fragments in
+// ascending numeric order.
+func FindFragments(fsys fs.FS, dir, base, ext string) ([]*Fragment, error) {
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return nil, fmt.Errorf("[%s]: FindFragments: failed to read directory: %w", dir, err)
+	}
+
+	base = NormalizeUnitName(base)
+	var fragments []*Fragment
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		fragment, ok := ParseFragmentName(entry.Name(), ext)
+		if !ok || fragment.Base != base {
+			continue
+		}
+		fragments = append(fragments, fragment)
+	}
+
+	sort.SliceStable(fragments, func(a, b int) bool {
+		fa, fb := fragments[a], fragments[b]
+		if fa.Kind != fb.Kind {
+			return fa.Kind < fb.Kind
+		}
+		if fa.Number != fb.Number {
+			return fa.Number < fb.Number
+		}
+		return fa.FileName < fb.FileName
+	})
+	return fragments, nil
+}
@@ -0,0 +1,49 @@
+package units
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindFragments(t *testing.T) {
+	fsys := fstest.MapFS{
+		"dir/sum-2.gnd":         {},
+		"dir/sum.gnd":           {},
+		"dir/020-sum.gnd":       {},
+		"dir/sum-1.gnd":         {},
+		"dir/010-Sum.gnd":       {},
+		"dir/100-sum.gnd":       {},
+		"dir/s.um-10.gnd":       {},
+		"dir/summary.gnd":       {},
+		"dir/sum.test.gnd":      {},
+		"dir/sum.llm":           {},
+		"dir/010-other.gnd":     {},
+		"dir/sum.gnd.llm":       {},
+		"dir/nested/sum.gnd":    {},
+		"dir/sum-dir.gnd/x.gnd": {},
+	}
+
+	fragments, err := FindFragments(fsys, "dir", "Sum", ".gnd")
+	assert.NoError(t, err)
+
+	var names []string
+	for _, f := range fragments {
+		names = append(names, f.FileName)
+	}
+	assert.Equal(t, []string{
+		"010-Sum.gnd",
+		"020-sum.gnd",
+		"100-sum.gnd",
+		"sum.gnd",
+		"sum-1.gnd",
+		"sum-2.gnd",
+		"s.um-10.gnd",
+	}, names)
+}
+
+func TestFindFragmentsMissingDir(t *testing.T) {
+	_, err := FindFragments(fstest.MapFS{}, "missing", "sum", ".gnd")
+	assert.Error(t, err)
+}
@@ -0,0 +1,71 @@
+package units
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// FragmentKind describes how a fragment is numbered
+type FragmentKind int
+
+const (
+	// PrefixFragment is numbered with a prefix, e.g. 010-sum.gnd
+	PrefixFragment FragmentKind = iota
+	// PlainFragment has no number, e.g. sum.gnd
+	PlainFragment
+	// SuffixFragment is numbered with a suffix, e.g. sum-1.gnd
+	SuffixFragment
+)
+
+var (
+	prefixFragmentPattern = regexp.MustCompile(`^([0-9]+)-(.+)$`)
+	suffixFragmentPattern = regexp.MustCompile(`^(.+?)-([0-9]+)(?:[^0-9].*)?$`)
+)
+
+// Fragment is a single file that is part of a unit
+type Fragment struct {
+	// FileName is the name of the file, e.g. 010-Sum.gnd
+	FileName string
+	// Base is the canonical base name of the unit, e.g. sum
+	Base string
+	// Kind is how the fragment is numbered
+	Kind FragmentKind
+	// Number is the numeric prefix or suffix, or 0 for plain fragments
+	Number uint64
+}
+
+// String returns a string representation of the Fragment
+func (f *Fragment) String() string {
+	return fmt.Sprintf("Fragment{%s, %s, %d, %d}", f.FileName, f.Base, f.Kind, f.Number)
+}
+
+// NormalizeUnitName canonicalizes a unit base name. Case differences and dots
+// are ignored, so "Sum", "sum" and "s.um" name the same unit.
+func NormalizeUnitName(name string) string {
+	return strings.ReplaceAll(strings.ToLower(name), ".", "")
+}
+
+// ParseFragmentName parses a file name as a fragment of a unit with the given
+// extension (e.g. ".gnd"). Returns false if the file does not have the extension.
+func ParseFragmentName(fileName, ext string) (*Fragment, bool) {
+	if len(fileName) <= len(ext) || !strings.EqualFold(fileName[len(fileName)-len(ext):], ext) {
+		return nil, false
+	}
+	stem := fileName[:len(fileName)-len(ext)]
+
+	if m := prefixFragmentPattern.FindStringSubmatch(stem); m != nil {
+		if number, err := strconv.ParseUint(m[1], 10, 64); err == nil {
+			return &Fragment{FileName: fileName, Base: NormalizeUnitName(m[2]), Kind: PrefixFragment, Number: number}, true
+		}
+	}
+
+	if m := suffixFragmentPattern.FindStringSubmatch(stem); m != nil {
+		if number, err := strconv.ParseUint(m[2], 10, 64); err == nil {
+			return &Fragment{FileName: fileName, Base: NormalizeUnitName(m[1]), Kind: SuffixFragment, Number: number}, true
+		}
+	}
+
+	return &Fragment{FileName: fileName, Base: NormalizeUnitName(stem), Kind: PlainFragment}, true
+}
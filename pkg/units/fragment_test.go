@@ -0,0 +1,76 @@
+package units
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseFragmentName(t *testing.T) {
+	tests := []struct {
+		name     string
+		fileName string
+		expected *Fragment
+	}{
+		{
+			name:     "plain",
+			fileName: "sum.gnd",
+			expected: &Fragment{FileName: "sum.gnd", Base: "sum", Kind: PlainFragment},
+		},
+		{
+			name:     "prefix",
+			fileName: "010-sum.gnd",
+			expected: &Fragment{FileName: "010-sum.gnd", Base: "sum", Kind: PrefixFragment, Number: 10},
+		},
+		{
+			name:     "suffix",
+			fileName: "sum-2.gnd",
+			expected: &Fragment{FileName: "sum-2.gnd", Base: "sum", Kind: SuffixFragment, Number: 2},
+		},
+		{
+			name:     "suffix ignores following characters",
+			fileName: "sum-12-setup.gnd",
+			expected: &Fragment{FileName: "sum-12-setup.gnd", Base: "sum", Kind: SuffixFragment, Number: 12},
+		},
+		{
+			name:     "case and dots are ignored",
+			fileName: "020-S.um.GND",
+			expected: &Fragment{FileName: "020-S.um.GND", Base: "sum", Kind: PrefixFragment, Number: 20},
+		},
+		{
+			name:     "hyphenated name",
+			fileName: "pad-left.gnd",
+			expected: &Fragment{FileName: "pad-left.gnd", Base: "pad-left", Kind: PlainFragment},
+		},
+		{
+			name:     "hyphenated name with suffix",
+			fileName: "pad-left-1.gnd",
+			expected: &Fragment{FileName: "pad-left-1.gnd", Base: "pad-left", Kind: SuffixFragment, Number: 1},
+		},
+		{
+			name:     "test unit",
+			fileName: "010-sum.test.gnd",
+			expected: &Fragment{FileName: "010-sum.test.gnd", Base: "sumtest", Kind: PrefixFragment, Number: 10},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := ParseFragmentName(tt.fileName, ".gnd")
+			assert.True(t, ok)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestParseFragmentNameWrongExtension(t *testing.T) {
+	for _, fileName := range []string{"sum.llm", "sum.gnd.llm", ".gnd", "sum"} {
+		_, ok := ParseFragmentName(fileName, ".gnd")
+		assert.False(t, ok, fileName)
+	}
+}
+
+func TestNormalizeUnitName(t *testing.T) {
+	assert.Equal(t, "sum", NormalizeUnitName("Sum"))
+	assert.Equal(t, "mysum", NormalizeUnitName("My.Sum"))
+}
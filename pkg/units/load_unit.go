@@ -0,0 +1,185 @@
+package units
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/hyperifyio/gnd/pkg/loggers"
+	"github.com/hyperifyio/gnd/pkg/parsers"
+)
+
+const (
+	GndExt = ".gnd"
+	GncExt = ".gnc"
+)
+
+// LoadUnitFS loads the unit that fileName belongs to. dir is the directory
+// inside fsys, and sourceDir is the directory used in source names for error
+// messages.
+//
+// A compiled .gnc file of the unit is preferred when it is at least as new as
+// every .gnd fragment. Otherwise all .gnd fragments are concatenated, see
+// LoadSourcesFS. If fileName is a .gnc file, it is loaded directly, and if it
+// has neither extension, only that file is parsed as .gnd source.
+//
+// The loaded unit is checked with parsers.ValidateInstructions.
+func LoadUnitFS(fsys fs.FS, dir, fileName, sourceDir string) ([]*parsers.Instruction, error) {
+
+	if strings.EqualFold(path.Ext(fileName), GncExt) {
+		return validateUnit(loadGnc(fsys, dir, fileName, sourceDir))
+	}
+
+	if fragment, ok := ParseFragmentName(fileName, GndExt); ok {
+		compiled, err := FindCompiled(fsys, dir, fragment.Base)
+		if err != nil {
+			return nil, err
+		}
+		if compiled != "" {
+			return validateUnit(loadGnc(fsys, dir, compiled, sourceDir))
+		}
+	}
+
+	return LoadSourcesFS(fsys, dir, fileName, sourceDir)
+}
+
+// LoadSourcesFS loads and concatenates all .gnd fragments of the unit that
+// fileName belongs to. Each fragment is parsed on its own, so errors point at
+// the original fragment file and line. The single-assignment rule is checked
+// across all fragments.
+func LoadSourcesFS(fsys fs.FS, dir, fileName, sourceDir string) ([]*parsers.Instruction, error) {
+	return validateUnit(loadSources(fsys, dir, fileName, sourceDir))
+}
+
+// loadSources loads and concatenates the .gnd fragments without validation
+func loadSources(fsys fs.FS, dir, fileName, sourceDir string) ([]*parsers.Instruction, error) {
+
+	fragment, ok := ParseFragmentName(fileName, GndExt)
+	if !ok {
+		return loadFragment(fsys, dir, fileName, sourceDir)
+	}
+
+	fragments, err := FindFragments(fsys, dir, fragment.Base, GndExt)
+	if err != nil {
+		return nil, err
+	}
+	if len(fragments) == 0 {
+		// Report the missing file itself
+		return loadFragment(fsys, dir, fileName, sourceDir)
+	}
+
+	var instructions []*parsers.Instruction
+	for _, f := range fragments {
+		fragmentInstructions, err := loadFragment(fsys, dir, f.FileName, sourceDir)
+		if err != nil {
+			return nil, err
+		}
+		instructions = append(instructions, fragmentInstructions...)
+	}
+	loggers.Printf(loggers.Debug, "[%s]: LoadUnit: loaded %d instructions from %d fragments", filepath.Join(sourceDir, fileName), len(instructions), len(fragments))
+	return instructions, nil
+}
+
+// FindCompiled returns the file name of the unit's compiled .gnc file if it
+// exists and is not older than any .gnd fragment, or an empty string.
+func FindCompiled(fsys fs.FS, dir, base string) (string, error) {
+	compiled, err := FindFragments(fsys, dir, base, GncExt)
+	if err != nil {
+		return "", err
+	}
+	var gnc *Fragment
+	for _, f := range compiled {
+		if f.Kind == PlainFragment {
+			gnc = f
+			break
+		}
+	}
+	if gnc == nil {
+		return "", nil
+	}
+
+	gncInfo, err := fs.Stat(fsys, path.Join(dir, gnc.FileName))
+	if err != nil {
+		return "", fmt.Errorf("[%s]: FindCompiled: %w", gnc.FileName, err)
+	}
+
+	sources, err := FindFragments(fsys, dir, base, GndExt)
+	if err != nil {
+		return "", err
+	}
+	for _, f := range sources {
+		info, err := fs.Stat(fsys, path.Join(dir, f.FileName))
+		if err != nil {
+			return "", fmt.Errorf("[%s]: FindCompiled: %w", f.FileName, err)
+		}
+		if info.ModTime().After(gncInfo.ModTime()) {
+			loggers.Printf(loggers.Debug, "[%s]: FindCompiled: ignoring stale %s", f.FileName, gnc.FileName)
+			return "", nil
+		}
+	}
+	return gnc.FileName, nil
+}
+
+// LoadUnitFile loads the unit that the file at filePath belongs to from the
+// operating system filesystem
+func LoadUnitFile(filePath string) ([]*parsers.Instruction, error) {
+	dir := filepath.Dir(filePath)
+	return LoadUnitFS(os.DirFS(dir), ".", filepath.Base(filePath), dir)
+}
+
+// LoadSourcesFile loads the .gnd fragments of the unit that the file at
+// filePath belongs to, ignoring any compiled .gnc file
+func LoadSourcesFile(filePath string) ([]*parsers.Instruction, error) {
+	dir := filepath.Dir(filePath)
+	return LoadSourcesFS(os.DirFS(dir), ".", filepath.Base(filePath), dir)
+}
+
+// validateUnit checks the single-assignment rule of loaded instructions
+func validateUnit(instructions []*parsers.Instruction, err error) ([]*parsers.Instruction, error) {
+	if err != nil {
+		return nil, err
+	}
+	if err := parsers.ValidateInstructions(instructions); err != nil {
+		return nil, fmt.Errorf("LoadUnit: invalid unit:\n%w", err)
+	}
+	return instructions, nil
+}
+
+// readUnitFile reads a file of a unit. Path errors report the source name
+// instead of the path inside fsys.
+func readUnitFile(fsys fs.FS, dir, fileName, source string) ([]byte, error) {
+	content, err := fs.ReadFile(fsys, path.Join(dir, fileName))
+	if err != nil {
+		var pathErr *fs.PathError
+		if errors.As(err, &pathErr) {
+			return nil, &fs.PathError{Op: pathErr.Op, Path: source, Err: pathErr.Err}
+		}
+		return nil, fmt.Errorf("[%s]: LoadUnit: failed to read: %w", source, err)
+	}
+	return content, nil
+}
+
+// loadFragment reads and parses a single .gnd file
+func loadFragment(fsys fs.FS, dir, fileName, sourceDir string) ([]*parsers.Instruction, error) {
+	source := filepath.Join(sourceDir, fileName)
+	content, err := readUnitFile(fsys, dir, fileName, source)
+	if err != nil {
+		return nil, err
+	}
+	return parsers.ParseInstructionLines(source, string(content))
+}
+
+// loadGnc reads and parses a single .gnc file
+func loadGnc(fsys fs.FS, dir, fileName, sourceDir string) ([]*parsers.Instruction, error) {
+	source := filepath.Join(sourceDir, fileName)
+	content, err := readUnitFile(fsys, dir, fileName, source)
+	if err != nil {
+		return nil, err
+	}
+	loggers.Printf(loggers.Debug, "[%s]: LoadUnit: loading compiled unit", source)
+	return parsers.ParseGnc(source, string(content))
+}
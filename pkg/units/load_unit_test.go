@@ -0,0 +1,113 @@
+package units
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadUnitFile(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"sum-1.gnd":   "$d let 4\n",
+		"010-sum.gnd": "# first fragment\n$a let 1\n",
+		"sum.gnd":     "$c let 3\n",
+		"020-sum.gnd": "$b let 2\n",
+		"other.gnd":   "$x let 0\n",
+	}
+	for name, content := range files {
+		assert.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0644))
+	}
+
+	// Any fragment of the unit loads the whole unit
+	for _, name := range []string{"sum.gnd", "020-sum.gnd", "sum-1.gnd"} {
+		instructions, err := LoadUnitFile(filepath.Join(dir, name))
+		assert.NoError(t, err)
+
+		var destinations []string
+		for _, op := range instructions {
+			destinations = append(destinations, op.Destination.Name)
+		}
+		assert.Equal(t, []string{"a", "b", "c", "d"}, destinations, name)
+	}
+}
+
+func TestLoadUnitFileReportsFragmentErrors(t *testing.T) {
+	dir := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "010-sum.gnd"), []byte("$a let 1\n"), 0644))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "020-sum.gnd"), []byte("$b let 2\n\n$c let \"oops\n"), 0644))
+
+	_, err := LoadUnitFile(filepath.Join(dir, "sum.gnd"))
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), filepath.Join(dir, "020-sum.gnd"))
+	assert.Contains(t, err.Error(), "line 3")
+}
+
+func TestLoadUnitFileMissing(t *testing.T) {
+	_, err := LoadUnitFile(filepath.Join(t.TempDir(), "missing.gnd"))
+	assert.Error(t, err)
+	assert.True(t, errors.Is(err, fs.ErrNotExist))
+}
+
+func TestLoadUnitFSNonGndFile(t *testing.T) {
+	fsys := fstest.MapFS{
+		"scripts/run.txt": {Data: []byte("$a let 1\n")},
+		"scripts/run.gnd": {Data: []byte("$b let 2\n")},
+	}
+	instructions, err := LoadUnitFS(fsys, "scripts", "run.txt", "/scripts")
+	assert.NoError(t, err)
+	assert.Len(t, instructions, 1)
+	assert.Equal(t, "a", instructions[0].Destination.Name)
+}
+
+func TestLoadUnitFilePrefersCompiled(t *testing.T) {
+	dir := t.TempDir()
+	source := filepath.Join(dir, "sum.gnd")
+	compiled := filepath.Join(dir, "sum.gnc")
+	assert.NoError(t, os.WriteFile(source, []byte("$a let 1\n"), 0644))
+	assert.NoError(t, os.WriteFile(compiled, []byte("gnc 1\n$b /gnd/let \"2\"\n"), 0644))
+
+	old := time.Now().Add(-time.Hour)
+	assert.NoError(t, os.Chtimes(source, old, old))
+
+	instructions, err := LoadUnitFile(source)
+	assert.NoError(t, err)
+	assert.Len(t, instructions, 1)
+	assert.Equal(t, "b", instructions[0].Destination.Name)
+
+	// A .gnc file is also loaded when given directly
+	instructions, err = LoadUnitFile(compiled)
+	assert.NoError(t, err)
+	assert.Equal(t, "/gnd/let", instructions[0].Opcode)
+
+	// A stale .gnc file is ignored
+	assert.NoError(t, os.Chtimes(compiled, old.Add(-time.Hour), old.Add(-time.Hour)))
+	instructions, err = LoadUnitFile(source)
+	assert.NoError(t, err)
+	assert.Equal(t, "a", instructions[0].Destination.Name)
+}
+
+func TestLoadUnitFSCompiledOnly(t *testing.T) {
+	fsys := fstest.MapFS{
+		"lib/sum.gnc": {Data: []byte("gnc 1\n$b /gnd/let \"2\"\n")},
+	}
+	instructions, err := LoadUnitFS(fsys, "lib", "sum.gnd", "lib")
+	assert.NoError(t, err)
+	assert.Len(t, instructions, 1)
+}
+
+func TestLoadUnitFileValidatesAcrossFragments(t *testing.T) {
+	dir := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "010-sum.gnd"), []byte("$a let 1\n"), 0644))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "sum.gnd"), []byte("$b concat $a\n$a let 2\n"), 0644))
+
+	_, err := LoadUnitFile(filepath.Join(dir, "sum.gnd"))
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), filepath.Join(dir, "sum.gnd")+":2: identifier is already bound: $a")
+}